@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
 	"github.com/uberspace-community/terraform-provider-uberspace/internal/provider"
 )
 
@@ -30,7 +31,12 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("please provide at least one asteroid name as argument")
 	}
 
-	c, err := client.NewClient("https://marvin.uberspace.is", client.WithClient(provider.NewAuthClient(apiKey)))
+	httpClient, err := provider.NewHTTPClient(provider.HTTPClientConfig{})
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP client: %w", err)
+	}
+
+	c, err := client.NewClient("https://marvin.uberspace.is", client.WithClient(provider.NewAuthClient(provider.APIKeys{Default: apiKey}, "terraform-provider-uberspace-reset", httpClient)))
 	if err != nil {
 		return fmt.Errorf("failed to create Uberspace client: %w", err)
 	}
@@ -51,14 +57,18 @@ func run(ctx context.Context) error {
 }
 
 func resetWebdomain(ctx context.Context, c *client.Client, asteroid string) error {
-	domains, err := c.AsteroidsWebdomainsList(ctx, client.AsteroidsWebdomainsListParams{
-		AsteroidName: asteroid,
+	domains, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebDomain], error) {
+		return c.AsteroidsWebdomainsList(ctx, client.AsteroidsWebdomainsListParams{
+			AsteroidName: asteroid,
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
 	})
 	if err != nil {
 		return fmt.Errorf("failed to list webdomains: %w", err)
 	}
 
-	for _, domain := range domains.Results {
+	for _, domain := range domains {
 		if err := resetWebdomainBackend(ctx, c, domain); err != nil {
 			return err
 		}
@@ -89,15 +99,19 @@ func resetWebdomain(ctx context.Context, c *client.Client, asteroid string) erro
 }
 
 func resetWebdomainBackend(ctx context.Context, c *client.Client, domain client.WebDomain) error {
-	backends, err := c.AsteroidsWebdomainsBackendsList(ctx, client.AsteroidsWebdomainsBackendsListParams{
-		AsteroidName:  domain.Asteroid,
-		WebdomainName: domain.Name,
+	backends, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebBackend], error) {
+		return c.AsteroidsWebdomainsBackendsList(ctx, client.AsteroidsWebdomainsBackendsListParams{
+			AsteroidName:  domain.Asteroid,
+			WebdomainName: domain.Name,
+			Limit:         client.NewOptInt(limit),
+			Offset:        client.NewOptInt(offset),
+		})
 	})
 	if err != nil {
 		return fmt.Errorf("failed to list backends for domain %s: %w", domain.Name, err)
 	}
 
-	for _, backend := range backends.Results {
+	for _, backend := range backends {
 		fmt.Printf("Deleting backend %s for domain %s\n", backend.Path, domain.Name)
 
 		if err := c.AsteroidsWebdomainsBackendsDelete(ctx, client.AsteroidsWebdomainsBackendsDeleteParams{
@@ -115,15 +129,19 @@ func resetWebdomainBackend(ctx context.Context, c *client.Client, domain client.
 }
 
 func resetWebdomainHeader(ctx context.Context, c *client.Client, domain client.WebDomain) error {
-	headers, err := c.AsteroidsWebdomainsHeadersList(ctx, client.AsteroidsWebdomainsHeadersListParams{
-		AsteroidName:  domain.Asteroid,
-		WebdomainName: domain.Name,
+	headers, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebHeader], error) {
+		return c.AsteroidsWebdomainsHeadersList(ctx, client.AsteroidsWebdomainsHeadersListParams{
+			AsteroidName:  domain.Asteroid,
+			WebdomainName: domain.Name,
+			Limit:         client.NewOptInt(limit),
+			Offset:        client.NewOptInt(offset),
+		})
 	})
 	if err != nil {
 		return fmt.Errorf("failed to list headers for domain %s: %w", domain.Name, err)
 	}
 
-	for _, header := range headers.Results {
+	for _, header := range headers {
 		fmt.Printf("Deleting header %s for domain %s\n", header.Name, domain.Name)
 
 		if err := c.AsteroidsWebdomainsHeadersDelete(ctx, client.AsteroidsWebdomainsHeadersDeleteParams{
@@ -141,14 +159,18 @@ func resetWebdomainHeader(ctx context.Context, c *client.Client, domain client.W
 }
 
 func resetMaildomain(ctx context.Context, c *client.Client, asteroid string) error {
-	mailDomains, err := c.AsteroidsMaildomainsList(ctx, client.AsteroidsMaildomainsListParams{
-		AsteroidName: asteroid,
+	mailDomains, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.MailDomain], error) {
+		return c.AsteroidsMaildomainsList(ctx, client.AsteroidsMaildomainsListParams{
+			AsteroidName: asteroid,
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
 	})
 	if err != nil {
 		return fmt.Errorf("failed to list maildomains: %w", err)
 	}
 
-	for _, domain := range mailDomains.Results {
+	for _, domain := range mailDomains {
 		if err := resetMailDomainUsers(ctx, c, domain); err != nil {
 			return err
 		}
@@ -180,15 +202,19 @@ func resetMaildomain(ctx context.Context, c *client.Client, asteroid string) err
 }
 
 func resetMailDomainUsers(ctx context.Context, c *client.Client, domain client.MailDomain) error {
-	backends, err := c.AsteroidsMaildomainsUsersList(ctx, client.AsteroidsMaildomainsUsersListParams{
-		AsteroidName:   domain.Asteroid,
-		MaildomainName: domain.Name,
+	backends, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.MailUser], error) {
+		return c.AsteroidsMaildomainsUsersList(ctx, client.AsteroidsMaildomainsUsersListParams{
+			AsteroidName:   domain.Asteroid,
+			MaildomainName: domain.Name,
+			Limit:          client.NewOptInt(limit),
+			Offset:         client.NewOptInt(offset),
+		})
 	})
 	if err != nil {
 		return fmt.Errorf("failed to list backends for maildomain %s: %w", domain.Name, err)
 	}
 
-	for _, user := range backends.Results {
+	for _, user := range backends {
 		if slices.Contains([]string{"sysmail", "postmaster", "abuse", "hostmaster"}, user.Name) {
 			fmt.Printf("Skipping deletion of system user %s for maildomain %s\n", user.Name, domain.Name)
 
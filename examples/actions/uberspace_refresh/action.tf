@@ -0,0 +1,8 @@
+# terraform apply -invoke=action.uberspace_refresh.webdomains
+action "uberspace_refresh" "webdomains" {
+  config {
+    asteroid = "isabell"
+    model    = "WebDomain"
+    client   = "web"
+  }
+}
@@ -0,0 +1,29 @@
+resource "uberspace_webdomain" "www" {
+  asteroid = "isabell"
+  name     = "isabell.example"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.uberspace_refresh_dns.www]
+    }
+  }
+}
+
+# re-check the DNS records of all web domains on the host right after the
+# domain was added and wait for the check of this domain
+action "uberspace_refresh_dns" "www" {
+  config {
+    asteroid  = uberspace_webdomain.www.asteroid
+    webdomain = uberspace_webdomain.www.name
+  }
+}
+
+# or on demand: terraform apply -invoke=action.uberspace_refresh_dns.mail
+action "uberspace_refresh_dns" "mail" {
+  config {
+    asteroid   = "isabell"
+    maildomain = "isabell.example"
+    wait       = false
+  }
+}
@@ -0,0 +1,6 @@
+data "uberspace_events" "failed" {
+  apikey_asteroid = "isabell"
+  state           = "FAILED"
+  max_age         = "24h"
+  include_logs    = true
+}
@@ -0,0 +1,7 @@
+data "uberspace_maildomains" "all" {
+  asteroid = "isabell"
+}
+
+output "maildomains" {
+  value = { for d in data.uberspace_maildomains.all.maildomains : d.name => d.dns_state }
+}
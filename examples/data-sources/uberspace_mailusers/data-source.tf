@@ -0,0 +1,10 @@
+data "uberspace_mailusers" "directory" {
+  asteroid   = "isabell"
+  maildomain = "example.org"
+}
+
+output "mailboxes" {
+  value = {
+    for u in data.uberspace_mailusers.directory.mailusers : u.mailaddr => u.forwards[*].destination
+  }
+}
@@ -0,0 +1,9 @@
+data "uberspace_webbackends" "minio" {
+  asteroid = "isabell"
+  domain   = "minio.isabell.uber.space"
+  path     = "/"
+}
+
+output "minio_port" {
+  value = one(data.uberspace_webbackends.minio.webbackends).port
+}
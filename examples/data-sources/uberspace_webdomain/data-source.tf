@@ -0,0 +1,8 @@
+data "uberspace_webdomain" "minio" {
+  asteroid = "isabell"
+  name     = "minio.isabell.uber.space"
+}
+
+output "minio_validation_token" {
+  value = data.uberspace_webdomain.minio.dns_validation_token
+}
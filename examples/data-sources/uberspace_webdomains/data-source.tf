@@ -0,0 +1,8 @@
+data "uberspace_webdomains" "invalid" {
+  asteroid  = "isabell"
+  dns_state = "INVALID"
+}
+
+output "invalid_domains" {
+  value = data.uberspace_webdomains.invalid.webdomains[*].name
+}
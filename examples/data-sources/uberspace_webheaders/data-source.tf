@@ -0,0 +1,9 @@
+data "uberspace_webheaders" "all" {
+  asteroid = "isabell"
+}
+
+output "headers" {
+  value = {
+    for h in data.uberspace_webheaders.all.webheaders : "${coalesce(h.domain, "*")}${h.path} ${h.name}" => h.value
+  }
+}
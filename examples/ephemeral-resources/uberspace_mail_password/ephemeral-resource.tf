@@ -0,0 +1,17 @@
+ephemeral "uberspace_mail_password" "info" {}
+
+resource "uberspace_mailuser" "info" {
+  name                     = "info"
+  asteroid_name            = "isabell"
+  maildomain_name          = "isabell.example"
+  password_hash_wo         = ephemeral.uberspace_mail_password.info.password_hash
+  password_hash_wo_version = 1
+}
+
+# hand the password to the new colleague, e.g. via a secret store
+resource "vault_kv_secret_v2" "info" {
+  mount                = "secret"
+  name                 = "mail/info"
+  data_json_wo         = jsonencode({ password = ephemeral.uberspace_mail_password.info.password })
+  data_json_wo_version = 1
+}
@@ -0,0 +1,6 @@
+resource "uberspace_mailuser" "info" {
+  name            = "info"
+  password_hash   = provider::uberspace::mail_password_hash(var.info_password, "Ne4ohQu8oht0")
+  asteroid_name   = "isabell"
+  maildomain_name = "isabell.example"
+}
@@ -0,0 +1,9 @@
+locals {
+  info = provider::uberspace::parse_mailaddr("info@isabell.example")
+}
+
+resource "uberspace_mailuser" "info" {
+  name            = local.info.local
+  asteroid_name   = "isabell"
+  maildomain_name = local.info.maildomain
+}
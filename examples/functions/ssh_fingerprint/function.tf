@@ -0,0 +1,3 @@
+output "deploy_key_fingerprint" {
+  value = provider::uberspace::ssh_fingerprint(file("~/.ssh/id_ed25519.pub"))
+}
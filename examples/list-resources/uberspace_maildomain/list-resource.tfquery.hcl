@@ -0,0 +1,7 @@
+list "uberspace_maildomain" "isabell" {
+  provider = uberspace
+
+  config {
+    asteroid = "isabell"
+  }
+}
@@ -0,0 +1,7 @@
+list "uberspace_mailuser" "isabell" {
+  provider = uberspace
+
+  config {
+    asteroid = "isabell"
+  }
+}
@@ -0,0 +1,7 @@
+list "uberspace_sshkey" "isabell" {
+  provider = uberspace
+
+  config {
+    asteroid = "isabell"
+  }
+}
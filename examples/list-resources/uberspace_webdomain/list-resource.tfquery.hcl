@@ -0,0 +1,7 @@
+list "uberspace_webdomain" "isabell" {
+  provider = uberspace
+
+  config {
+    asteroid = "isabell"
+  }
+}
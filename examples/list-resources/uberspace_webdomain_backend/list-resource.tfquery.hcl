@@ -0,0 +1,7 @@
+list "uberspace_webdomain_backend" "isabell" {
+  provider = uberspace
+
+  config {
+    asteroid = "isabell"
+  }
+}
@@ -0,0 +1,7 @@
+list "uberspace_webdomain_header" "isabell" {
+  provider = uberspace
+
+  config {
+    asteroid = "isabell"
+  }
+}
@@ -4,3 +4,14 @@
 provider "uberspace" {
   apikey = "example-api-key"
 }
+
+# Manage asteroids of several Uberspace accounts with a single provider.
+provider "uberspace" {
+  alias  = "team"
+  apikey = "example-api-key"
+
+  asteroid_apikeys = {
+    "isabell" = "example-api-key-isabell"
+    "team-*"  = "example-api-key-team"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = uberspace_maildomain.example
+  identity = {
+    asteroid = "isabell"
+    name     = "isabell.example"
+  }
+}
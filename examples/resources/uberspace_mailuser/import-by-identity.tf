@@ -0,0 +1,8 @@
+import {
+  to = uberspace_mailuser.example
+  identity = {
+    asteroid   = "isabell"
+    maildomain = "isabell.example"
+    local      = "info"
+  }
+}
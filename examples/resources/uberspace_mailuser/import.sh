@@ -0,0 +1,2 @@
+# ID of the identity attributes joined by slashes
+terraform import uberspace_mailuser.example isabell/isabell.example/info
@@ -0,0 +1,7 @@
+import {
+  to = uberspace_sshkey.example
+  identity = {
+    asteroid = "isabell"
+    id       = 42
+  }
+}
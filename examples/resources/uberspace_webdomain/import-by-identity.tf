@@ -0,0 +1,7 @@
+import {
+  to = uberspace_webdomain.example
+  identity = {
+    asteroid = "isabell"
+    name     = "isabell.example"
+  }
+}
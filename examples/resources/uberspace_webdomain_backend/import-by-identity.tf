@@ -0,0 +1,8 @@
+import {
+  to = uberspace_webdomain_backend.example
+  identity = {
+    asteroid = "isabell"
+    domain   = "isabell.example"
+    path     = "/api"
+  }
+}
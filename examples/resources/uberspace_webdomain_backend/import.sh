@@ -0,0 +1,2 @@
+# ID of the identity attributes joined by slashes
+terraform import uberspace_webdomain_backend.example isabell/isabell.example//api
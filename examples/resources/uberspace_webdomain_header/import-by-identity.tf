@@ -0,0 +1,8 @@
+import {
+  to = uberspace_webdomain_header.example
+  identity = {
+    asteroid = "isabell"
+    domain   = "isabell.example"
+    id       = "42"
+  }
+}
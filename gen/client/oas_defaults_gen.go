@@ -142,6 +142,30 @@ func (s *PatchedMailUserRequestMultipart) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *RefreshEvent) setDefaults() {
+	{
+		val := int(0)
+		s.JobPriority.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *RefreshEventRequest) setDefaults() {
+	{
+		val := int(0)
+		s.JobPriority.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *RefreshEventRequestMultipart) setDefaults() {
+	{
+		val := int(0)
+		s.JobPriority.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *RelatedMailDomainField) setDefaults() {
 	{
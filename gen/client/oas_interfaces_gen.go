@@ -40,3 +40,7 @@ type AsteroidsWebdomainsCreateReq interface {
 type AsteroidsWebdomainsHeadersCreateReq interface {
 	asteroidsWebdomainsHeadersCreateReq()
 }
+
+type RefresheventsCreateReq interface {
+	refresheventsCreateReq()
+}
@@ -774,6 +774,50 @@ func (s *AsteroidsWebdomainsHeadersCreateApplicationXWwwFormUrlencoded) Unmarsha
 	return s.Decode(d)
 }
 
+// Encode encodes CauseEnum as json.
+func (s CauseEnum) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CauseEnum from json.
+func (s *CauseEnum) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CauseEnum to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CauseEnum(v) {
+	case CauseEnumDELETED:
+		*s = CauseEnumDELETED
+	case CauseEnumCHANGED:
+		*s = CauseEnumCHANGED
+	case CauseEnumCREATED:
+		*s = CauseEnumCREATED
+	case CauseEnumREFRESH:
+		*s = CauseEnumREFRESH
+	default:
+		*s = CauseEnum(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CauseEnum) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CauseEnum) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes DestinationEnum as json.
 func (s DestinationEnum) Encode(e *jx.Encoder) {
 	e.Str(string(s))
@@ -862,6 +906,259 @@ func (s *DnsStateEnum) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *Event) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Event) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("pk")
+		e.Int(s.Pk)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("content_type")
+		e.Str(s.ContentType)
+	}
+	{
+		e.FieldStart("object_id")
+		s.ObjectID.Encode(e)
+	}
+	{
+		e.FieldStart("object_name")
+		s.ObjectName.Encode(e)
+	}
+	{
+		e.FieldStart("human_identifier")
+		e.Str(s.HumanIdentifier)
+	}
+	{
+		e.FieldStart("cause")
+		s.Cause.Encode(e)
+	}
+	{
+		e.FieldStart("state")
+		s.State.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfEvent = [8]string{
+	0: "pk",
+	1: "created_at",
+	2: "content_type",
+	3: "object_id",
+	4: "object_name",
+	5: "human_identifier",
+	6: "cause",
+	7: "state",
+}
+
+// Decode decodes Event from json.
+func (s *Event) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Event to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "pk":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Pk = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pk\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "content_type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.ContentType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"content_type\"")
+			}
+		case "object_id":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.ObjectID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"object_id\"")
+			}
+		case "object_name":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.ObjectName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"object_name\"")
+			}
+		case "human_identifier":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.HumanIdentifier = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"human_identifier\"")
+			}
+		case "cause":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.Cause.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cause\"")
+			}
+		case "state":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				if err := s.State.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"state\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Event")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEvent) {
+					name = jsonFieldsNameOfEvent[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Event) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Event) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EventStateEnum as json.
+func (s EventStateEnum) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EventStateEnum from json.
+func (s *EventStateEnum) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventStateEnum to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EventStateEnum(v) {
+	case EventStateEnumPENDING:
+		*s = EventStateEnumPENDING
+	case EventStateEnumRUNNING:
+		*s = EventStateEnumRUNNING
+	case EventStateEnumFAILED:
+		*s = EventStateEnumFAILED
+	case EventStateEnumSKIPPED:
+		*s = EventStateEnumSKIPPED
+	case EventStateEnumDONE:
+		*s = EventStateEnumDONE
+	default:
+		*s = EventStateEnum(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventStateEnum) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventStateEnum) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *ExternalAsteroid) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -1130,44 +1427,221 @@ func (s *ExternalAsteroid) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
-// Encode encodes KeyTypeEnum as json.
-func (s KeyTypeEnum) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *JobLog) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes KeyTypeEnum from json.
-func (s *KeyTypeEnum) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode KeyTypeEnum to nil")
+// encodeFields encodes fields.
+func (s *JobLog) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		e.FieldStart("job")
+		e.Int(s.Job)
 	}
-	// Try to use constant string.
-	switch KeyTypeEnum(v) {
-	case KeyTypeEnumSkEcdsaSha2Nistp256OpensshCom:
-		*s = KeyTypeEnumSkEcdsaSha2Nistp256OpensshCom
-	case KeyTypeEnumEcdsaSha2Nistp256:
-		*s = KeyTypeEnumEcdsaSha2Nistp256
-	case KeyTypeEnumEcdsaSha2Nistp384:
-		*s = KeyTypeEnumEcdsaSha2Nistp384
-	case KeyTypeEnumEcdsaSha2Nistp521:
-		*s = KeyTypeEnumEcdsaSha2Nistp521
-	case KeyTypeEnumSkSSHEd25519OpensshCom:
-		*s = KeyTypeEnumSkSSHEd25519OpensshCom
-	case KeyTypeEnumSSHEd25519:
-		*s = KeyTypeEnumSSHEd25519
-	case KeyTypeEnumSSHRsa:
-		*s = KeyTypeEnumSSHRsa
-	default:
-		*s = KeyTypeEnum(v)
+	{
+		e.FieldStart("state_old")
+		s.StateOld.Encode(e)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
+	{
+		e.FieldStart("state_new")
+		s.StateNew.Encode(e)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+	{
+		e.FieldStart("client_name")
+		e.Str(s.ClientName)
+	}
+}
+
+var jsonFieldsNameOfJobLog = [6]string{
+	0: "created_at",
+	1: "job",
+	2: "state_old",
+	3: "state_new",
+	4: "message",
+	5: "client_name",
+}
+
+// Decode decodes JobLog from json.
+func (s *JobLog) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobLog to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "created_at":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "job":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Job = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"job\"")
+			}
+		case "state_old":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.StateOld.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"state_old\"")
+			}
+		case "state_new":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.StateNew.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"state_new\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "client_name":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.ClientName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client_name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobLog")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfJobLog) {
+					name = jsonFieldsNameOfJobLog[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobLog) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobLog) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes KeyTypeEnum as json.
+func (s KeyTypeEnum) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes KeyTypeEnum from json.
+func (s *KeyTypeEnum) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode KeyTypeEnum to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch KeyTypeEnum(v) {
+	case KeyTypeEnumSkEcdsaSha2Nistp256OpensshCom:
+		*s = KeyTypeEnumSkEcdsaSha2Nistp256OpensshCom
+	case KeyTypeEnumEcdsaSha2Nistp256:
+		*s = KeyTypeEnumEcdsaSha2Nistp256
+	case KeyTypeEnumEcdsaSha2Nistp384:
+		*s = KeyTypeEnumEcdsaSha2Nistp384
+	case KeyTypeEnumEcdsaSha2Nistp521:
+		*s = KeyTypeEnumEcdsaSha2Nistp521
+	case KeyTypeEnumSkSSHEd25519OpensshCom:
+		*s = KeyTypeEnumSkSSHEd25519OpensshCom
+	case KeyTypeEnumSSHEd25519:
+		*s = KeyTypeEnumSSHEd25519
+	case KeyTypeEnumSSHRsa:
+		*s = KeyTypeEnumSSHRsa
+	default:
+		*s = KeyTypeEnum(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
 func (s KeyTypeEnum) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
@@ -2384,6 +2858,52 @@ func (s *NilDateTime) UnmarshalJSON(data []byte) error {
 	return s.Decode(d, json.DecodeDateTime)
 }
 
+// Encode encodes int as json.
+func (o NilInt) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *NilInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilInt to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes string as json.
 func (o NilString) Encode(e *jx.Encoder) {
 	if o.Null {
@@ -2465,6 +2985,41 @@ func (s *OptBool) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes int as json.
 func (o OptNilInt) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -2753,14 +3308,14 @@ func (s *OptString) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedMailDomainList) Encode(e *jx.Encoder) {
+func (s *PaginatedEventList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedMailDomainList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedEventList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -2787,17 +3342,17 @@ func (s *PaginatedMailDomainList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedMailDomainList = [4]string{
+var jsonFieldsNameOfPaginatedEventList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedMailDomainList from json.
-func (s *PaginatedMailDomainList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedEventList from json.
+func (s *PaginatedEventList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedMailDomainList to nil")
+		return errors.New("invalid: unable to decode PaginatedEventList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2838,9 +3393,9 @@ func (s *PaginatedMailDomainList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]MailDomain, 0)
+				s.Results = make([]Event, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem MailDomain
+					var elem Event
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -2858,7 +3413,7 @@ func (s *PaginatedMailDomainList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedMailDomainList")
+		return errors.Wrap(err, "decode PaginatedEventList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2875,8 +3430,8 @@ func (s *PaginatedMailDomainList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedMailDomainList) {
-					name = jsonFieldsNameOfPaginatedMailDomainList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedEventList) {
+					name = jsonFieldsNameOfPaginatedEventList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2897,27 +3452,27 @@ func (s *PaginatedMailDomainList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedMailDomainList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedEventList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedMailDomainList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedEventList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedMailForwardList) Encode(e *jx.Encoder) {
+func (s *PaginatedJobLogList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedMailForwardList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedJobLogList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -2944,17 +3499,17 @@ func (s *PaginatedMailForwardList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedMailForwardList = [4]string{
+var jsonFieldsNameOfPaginatedJobLogList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedMailForwardList from json.
-func (s *PaginatedMailForwardList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedJobLogList from json.
+func (s *PaginatedJobLogList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedMailForwardList to nil")
+		return errors.New("invalid: unable to decode PaginatedJobLogList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2995,9 +3550,9 @@ func (s *PaginatedMailForwardList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]MailForward, 0)
+				s.Results = make([]JobLog, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem MailForward
+					var elem JobLog
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3015,7 +3570,7 @@ func (s *PaginatedMailForwardList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedMailForwardList")
+		return errors.Wrap(err, "decode PaginatedJobLogList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3032,8 +3587,8 @@ func (s *PaginatedMailForwardList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedMailForwardList) {
-					name = jsonFieldsNameOfPaginatedMailForwardList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedJobLogList) {
+					name = jsonFieldsNameOfPaginatedJobLogList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3054,27 +3609,27 @@ func (s *PaginatedMailForwardList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedMailForwardList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedJobLogList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedMailForwardList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedJobLogList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedMailUserList) Encode(e *jx.Encoder) {
+func (s *PaginatedMailDomainList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedMailUserList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedMailDomainList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -3101,17 +3656,17 @@ func (s *PaginatedMailUserList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedMailUserList = [4]string{
+var jsonFieldsNameOfPaginatedMailDomainList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedMailUserList from json.
-func (s *PaginatedMailUserList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedMailDomainList from json.
+func (s *PaginatedMailDomainList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedMailUserList to nil")
+		return errors.New("invalid: unable to decode PaginatedMailDomainList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3152,9 +3707,9 @@ func (s *PaginatedMailUserList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]MailUser, 0)
+				s.Results = make([]MailDomain, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem MailUser
+					var elem MailDomain
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3172,7 +3727,7 @@ func (s *PaginatedMailUserList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedMailUserList")
+		return errors.Wrap(err, "decode PaginatedMailDomainList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3189,8 +3744,8 @@ func (s *PaginatedMailUserList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedMailUserList) {
-					name = jsonFieldsNameOfPaginatedMailUserList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedMailDomainList) {
+					name = jsonFieldsNameOfPaginatedMailDomainList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3211,27 +3766,27 @@ func (s *PaginatedMailUserList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedMailUserList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedMailDomainList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedMailUserList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedMailDomainList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedSelectedToolVersionList) Encode(e *jx.Encoder) {
+func (s *PaginatedMailForwardList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedSelectedToolVersionList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedMailForwardList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -3258,17 +3813,17 @@ func (s *PaginatedSelectedToolVersionList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedSelectedToolVersionList = [4]string{
+var jsonFieldsNameOfPaginatedMailForwardList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedSelectedToolVersionList from json.
-func (s *PaginatedSelectedToolVersionList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedMailForwardList from json.
+func (s *PaginatedMailForwardList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedSelectedToolVersionList to nil")
+		return errors.New("invalid: unable to decode PaginatedMailForwardList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3309,9 +3864,9 @@ func (s *PaginatedSelectedToolVersionList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]SelectedToolVersion, 0)
+				s.Results = make([]MailForward, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem SelectedToolVersion
+					var elem MailForward
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3329,7 +3884,7 @@ func (s *PaginatedSelectedToolVersionList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedSelectedToolVersionList")
+		return errors.Wrap(err, "decode PaginatedMailForwardList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3346,8 +3901,8 @@ func (s *PaginatedSelectedToolVersionList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedSelectedToolVersionList) {
-					name = jsonFieldsNameOfPaginatedSelectedToolVersionList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedMailForwardList) {
+					name = jsonFieldsNameOfPaginatedMailForwardList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3368,27 +3923,27 @@ func (s *PaginatedSelectedToolVersionList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedSelectedToolVersionList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedMailForwardList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedSelectedToolVersionList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedMailForwardList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedSshKeyList) Encode(e *jx.Encoder) {
+func (s *PaginatedMailUserList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedSshKeyList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedMailUserList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -3415,17 +3970,17 @@ func (s *PaginatedSshKeyList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedSshKeyList = [4]string{
+var jsonFieldsNameOfPaginatedMailUserList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedSshKeyList from json.
-func (s *PaginatedSshKeyList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedMailUserList from json.
+func (s *PaginatedMailUserList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedSshKeyList to nil")
+		return errors.New("invalid: unable to decode PaginatedMailUserList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3466,9 +4021,9 @@ func (s *PaginatedSshKeyList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]SshKey, 0)
+				s.Results = make([]MailUser, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem SshKey
+					var elem MailUser
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3486,7 +4041,7 @@ func (s *PaginatedSshKeyList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedSshKeyList")
+		return errors.Wrap(err, "decode PaginatedMailUserList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3503,8 +4058,8 @@ func (s *PaginatedSshKeyList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedSshKeyList) {
-					name = jsonFieldsNameOfPaginatedSshKeyList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedMailUserList) {
+					name = jsonFieldsNameOfPaginatedMailUserList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3525,27 +4080,27 @@ func (s *PaginatedSshKeyList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedSshKeyList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedMailUserList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedSshKeyList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedMailUserList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedToolList) Encode(e *jx.Encoder) {
+func (s *PaginatedSelectedToolVersionList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedToolList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedSelectedToolVersionList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -3572,17 +4127,17 @@ func (s *PaginatedToolList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedToolList = [4]string{
+var jsonFieldsNameOfPaginatedSelectedToolVersionList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedToolList from json.
-func (s *PaginatedToolList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedSelectedToolVersionList from json.
+func (s *PaginatedSelectedToolVersionList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedToolList to nil")
+		return errors.New("invalid: unable to decode PaginatedSelectedToolVersionList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3623,9 +4178,9 @@ func (s *PaginatedToolList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]Tool, 0)
+				s.Results = make([]SelectedToolVersion, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Tool
+					var elem SelectedToolVersion
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3643,7 +4198,7 @@ func (s *PaginatedToolList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedToolList")
+		return errors.Wrap(err, "decode PaginatedSelectedToolVersionList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3660,8 +4215,8 @@ func (s *PaginatedToolList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedToolList) {
-					name = jsonFieldsNameOfPaginatedToolList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedSelectedToolVersionList) {
+					name = jsonFieldsNameOfPaginatedSelectedToolVersionList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3682,27 +4237,27 @@ func (s *PaginatedToolList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedToolList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedSelectedToolVersionList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedToolList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedSelectedToolVersionList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedToolVersionList) Encode(e *jx.Encoder) {
+func (s *PaginatedSshKeyList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedToolVersionList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedSshKeyList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -3729,17 +4284,17 @@ func (s *PaginatedToolVersionList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedToolVersionList = [4]string{
+var jsonFieldsNameOfPaginatedSshKeyList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedToolVersionList from json.
-func (s *PaginatedToolVersionList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedSshKeyList from json.
+func (s *PaginatedSshKeyList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedToolVersionList to nil")
+		return errors.New("invalid: unable to decode PaginatedSshKeyList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3780,9 +4335,9 @@ func (s *PaginatedToolVersionList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]ToolVersion, 0)
+				s.Results = make([]SshKey, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ToolVersion
+					var elem SshKey
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3800,7 +4355,7 @@ func (s *PaginatedToolVersionList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedToolVersionList")
+		return errors.Wrap(err, "decode PaginatedSshKeyList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3817,8 +4372,8 @@ func (s *PaginatedToolVersionList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedToolVersionList) {
-					name = jsonFieldsNameOfPaginatedToolVersionList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedSshKeyList) {
+					name = jsonFieldsNameOfPaginatedSshKeyList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3839,27 +4394,27 @@ func (s *PaginatedToolVersionList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedToolVersionList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedSshKeyList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedToolVersionList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedSshKeyList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedWebBackendList) Encode(e *jx.Encoder) {
+func (s *PaginatedToolList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedWebBackendList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedToolList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -3886,17 +4441,17 @@ func (s *PaginatedWebBackendList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedWebBackendList = [4]string{
+var jsonFieldsNameOfPaginatedToolList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedWebBackendList from json.
-func (s *PaginatedWebBackendList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedToolList from json.
+func (s *PaginatedToolList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedWebBackendList to nil")
+		return errors.New("invalid: unable to decode PaginatedToolList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3937,9 +4492,9 @@ func (s *PaginatedWebBackendList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]WebBackend, 0)
+				s.Results = make([]Tool, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem WebBackend
+					var elem Tool
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3957,7 +4512,7 @@ func (s *PaginatedWebBackendList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedWebBackendList")
+		return errors.Wrap(err, "decode PaginatedToolList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3974,8 +4529,8 @@ func (s *PaginatedWebBackendList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedWebBackendList) {
-					name = jsonFieldsNameOfPaginatedWebBackendList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedToolList) {
+					name = jsonFieldsNameOfPaginatedToolList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3996,27 +4551,27 @@ func (s *PaginatedWebBackendList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedWebBackendList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedToolList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedWebBackendList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedToolList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedWebDomainList) Encode(e *jx.Encoder) {
+func (s *PaginatedToolVersionList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedWebDomainList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedToolVersionList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -4043,17 +4598,17 @@ func (s *PaginatedWebDomainList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedWebDomainList = [4]string{
+var jsonFieldsNameOfPaginatedToolVersionList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedWebDomainList from json.
-func (s *PaginatedWebDomainList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedToolVersionList from json.
+func (s *PaginatedToolVersionList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedWebDomainList to nil")
+		return errors.New("invalid: unable to decode PaginatedToolVersionList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4094,9 +4649,9 @@ func (s *PaginatedWebDomainList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]WebDomain, 0)
+				s.Results = make([]ToolVersion, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem WebDomain
+					var elem ToolVersion
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -4114,7 +4669,7 @@ func (s *PaginatedWebDomainList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedWebDomainList")
+		return errors.Wrap(err, "decode PaginatedToolVersionList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4131,8 +4686,8 @@ func (s *PaginatedWebDomainList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedWebDomainList) {
-					name = jsonFieldsNameOfPaginatedWebDomainList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedToolVersionList) {
+					name = jsonFieldsNameOfPaginatedToolVersionList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4153,27 +4708,27 @@ func (s *PaginatedWebDomainList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedWebDomainList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedToolVersionList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedWebDomainList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedToolVersionList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaginatedWebHeaderList) Encode(e *jx.Encoder) {
+func (s *PaginatedWebBackendList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaginatedWebHeaderList) encodeFields(e *jx.Encoder) {
+func (s *PaginatedWebBackendList) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("count")
 		e.Int(s.Count)
@@ -4200,17 +4755,17 @@ func (s *PaginatedWebHeaderList) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPaginatedWebHeaderList = [4]string{
+var jsonFieldsNameOfPaginatedWebBackendList = [4]string{
 	0: "count",
 	1: "next",
 	2: "previous",
 	3: "results",
 }
 
-// Decode decodes PaginatedWebHeaderList from json.
-func (s *PaginatedWebHeaderList) Decode(d *jx.Decoder) error {
+// Decode decodes PaginatedWebBackendList from json.
+func (s *PaginatedWebBackendList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaginatedWebHeaderList to nil")
+		return errors.New("invalid: unable to decode PaginatedWebBackendList to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4251,9 +4806,9 @@ func (s *PaginatedWebHeaderList) Decode(d *jx.Decoder) error {
 		case "results":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Results = make([]WebHeader, 0)
+				s.Results = make([]WebBackend, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem WebHeader
+					var elem WebBackend
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -4271,7 +4826,7 @@ func (s *PaginatedWebHeaderList) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginatedWebHeaderList")
+		return errors.Wrap(err, "decode PaginatedWebBackendList")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4288,8 +4843,8 @@ func (s *PaginatedWebHeaderList) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginatedWebHeaderList) {
-					name = jsonFieldsNameOfPaginatedWebHeaderList[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPaginatedWebBackendList) {
+					name = jsonFieldsNameOfPaginatedWebBackendList[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4310,358 +4865,1044 @@ func (s *PaginatedWebHeaderList) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginatedWebHeaderList) MarshalJSON() ([]byte, error) {
+func (s *PaginatedWebBackendList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginatedWebHeaderList) UnmarshalJSON(data []byte) error {
+func (s *PaginatedWebBackendList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PatchedExternalAsteroidRequest) Encode(e *jx.Encoder) {
+func (s *PaginatedWebDomainList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PatchedExternalAsteroidRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.FlagLogErrorPhp.Set {
-			e.FieldStart("flag_log_error_php")
-			s.FlagLogErrorPhp.Encode(e)
-		}
-	}
-	{
-		if s.FlagLogErrorApache.Set {
-			e.FieldStart("flag_log_error_apache")
-			s.FlagLogErrorApache.Encode(e)
-		}
-	}
+func (s *PaginatedWebDomainList) encodeFields(e *jx.Encoder) {
 	{
-		if s.FlagLogAccessNginx.Set {
-			e.FieldStart("flag_log_access_nginx")
-			s.FlagLogAccessNginx.Encode(e)
-		}
+		e.FieldStart("count")
+		e.Int(s.Count)
 	}
 	{
-		if s.FlagPageReplace500.Set {
-			e.FieldStart("flag_page_replace_500")
-			s.FlagPageReplace500.Encode(e)
+		if s.Next.Set {
+			e.FieldStart("next")
+			s.Next.Encode(e)
 		}
 	}
 	{
-		if s.Password.Set {
-			e.FieldStart("password")
-			s.Password.Encode(e)
+		if s.Previous.Set {
+			e.FieldStart("previous")
+			s.Previous.Encode(e)
 		}
 	}
 	{
-		if s.PasswordHash.Set {
-			e.FieldStart("password_hash")
-			s.PasswordHash.Encode(e)
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfPatchedExternalAsteroidRequest = [6]string{
-	0: "flag_log_error_php",
-	1: "flag_log_error_apache",
-	2: "flag_log_access_nginx",
-	3: "flag_page_replace_500",
-	4: "password",
-	5: "password_hash",
-}
+var jsonFieldsNameOfPaginatedWebDomainList = [4]string{
+	0: "count",
+	1: "next",
+	2: "previous",
+	3: "results",
+}
+
+// Decode decodes PaginatedWebDomainList from json.
+func (s *PaginatedWebDomainList) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PaginatedWebDomainList to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "count":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Count = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "next":
+			if err := func() error {
+				s.Next.Reset()
+				if err := s.Next.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next\"")
+			}
+		case "previous":
+			if err := func() error {
+				s.Previous.Reset()
+				if err := s.Previous.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"previous\"")
+			}
+		case "results":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Results = make([]WebDomain, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem WebDomain
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PaginatedWebDomainList")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPaginatedWebDomainList) {
+					name = jsonFieldsNameOfPaginatedWebDomainList[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PaginatedWebDomainList) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PaginatedWebDomainList) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PaginatedWebHeaderList) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PaginatedWebHeaderList) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("count")
+		e.Int(s.Count)
+	}
+	{
+		if s.Next.Set {
+			e.FieldStart("next")
+			s.Next.Encode(e)
+		}
+	}
+	{
+		if s.Previous.Set {
+			e.FieldStart("previous")
+			s.Previous.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfPaginatedWebHeaderList = [4]string{
+	0: "count",
+	1: "next",
+	2: "previous",
+	3: "results",
+}
+
+// Decode decodes PaginatedWebHeaderList from json.
+func (s *PaginatedWebHeaderList) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PaginatedWebHeaderList to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "count":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Count = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "next":
+			if err := func() error {
+				s.Next.Reset()
+				if err := s.Next.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next\"")
+			}
+		case "previous":
+			if err := func() error {
+				s.Previous.Reset()
+				if err := s.Previous.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"previous\"")
+			}
+		case "results":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Results = make([]WebHeader, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem WebHeader
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PaginatedWebHeaderList")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPaginatedWebHeaderList) {
+					name = jsonFieldsNameOfPaginatedWebHeaderList[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PaginatedWebHeaderList) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PaginatedWebHeaderList) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PatchedExternalAsteroidRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatchedExternalAsteroidRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.FlagLogErrorPhp.Set {
+			e.FieldStart("flag_log_error_php")
+			s.FlagLogErrorPhp.Encode(e)
+		}
+	}
+	{
+		if s.FlagLogErrorApache.Set {
+			e.FieldStart("flag_log_error_apache")
+			s.FlagLogErrorApache.Encode(e)
+		}
+	}
+	{
+		if s.FlagLogAccessNginx.Set {
+			e.FieldStart("flag_log_access_nginx")
+			s.FlagLogAccessNginx.Encode(e)
+		}
+	}
+	{
+		if s.FlagPageReplace500.Set {
+			e.FieldStart("flag_page_replace_500")
+			s.FlagPageReplace500.Encode(e)
+		}
+	}
+	{
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
+		}
+	}
+	{
+		if s.PasswordHash.Set {
+			e.FieldStart("password_hash")
+			s.PasswordHash.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPatchedExternalAsteroidRequest = [6]string{
+	0: "flag_log_error_php",
+	1: "flag_log_error_apache",
+	2: "flag_log_access_nginx",
+	3: "flag_page_replace_500",
+	4: "password",
+	5: "password_hash",
+}
+
+// Decode decodes PatchedExternalAsteroidRequest from json.
+func (s *PatchedExternalAsteroidRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchedExternalAsteroidRequest to nil")
+	}
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "flag_log_error_php":
+			if err := func() error {
+				s.FlagLogErrorPhp.Reset()
+				if err := s.FlagLogErrorPhp.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flag_log_error_php\"")
+			}
+		case "flag_log_error_apache":
+			if err := func() error {
+				s.FlagLogErrorApache.Reset()
+				if err := s.FlagLogErrorApache.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flag_log_error_apache\"")
+			}
+		case "flag_log_access_nginx":
+			if err := func() error {
+				s.FlagLogAccessNginx.Reset()
+				if err := s.FlagLogAccessNginx.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flag_log_access_nginx\"")
+			}
+		case "flag_page_replace_500":
+			if err := func() error {
+				s.FlagPageReplace500.Reset()
+				if err := s.FlagPageReplace500.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flag_page_replace_500\"")
+			}
+		case "password":
+			if err := func() error {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		case "password_hash":
+			if err := func() error {
+				s.PasswordHash.Reset()
+				if err := s.PasswordHash.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password_hash\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchedExternalAsteroidRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PatchedExternalAsteroidRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PatchedExternalAsteroidRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PatchedMailUserRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatchedMailUserRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.PasswordHash.Set {
+			e.FieldStart("password_hash")
+			s.PasswordHash.Encode(e)
+		}
+	}
+	{
+		if s.AliasOf.Set {
+			e.FieldStart("alias_of")
+			s.AliasOf.Encode(e)
+		}
+	}
+	{
+		if s.KeepForwards.Set {
+			e.FieldStart("keep_forwards")
+			s.KeepForwards.Encode(e)
+		}
+	}
+	{
+		if s.IsSysmail.Set {
+			e.FieldStart("is_sysmail")
+			s.IsSysmail.Encode(e)
+		}
+	}
+	{
+		if s.IsCatchall.Set {
+			e.FieldStart("is_catchall")
+			s.IsCatchall.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPatchedMailUserRequest = [5]string{
+	0: "password_hash",
+	1: "alias_of",
+	2: "keep_forwards",
+	3: "is_sysmail",
+	4: "is_catchall",
+}
+
+// Decode decodes PatchedMailUserRequest from json.
+func (s *PatchedMailUserRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchedMailUserRequest to nil")
+	}
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "password_hash":
+			if err := func() error {
+				s.PasswordHash.Reset()
+				if err := s.PasswordHash.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password_hash\"")
+			}
+		case "alias_of":
+			if err := func() error {
+				s.AliasOf.Reset()
+				if err := s.AliasOf.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"alias_of\"")
+			}
+		case "keep_forwards":
+			if err := func() error {
+				s.KeepForwards.Reset()
+				if err := s.KeepForwards.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"keep_forwards\"")
+			}
+		case "is_sysmail":
+			if err := func() error {
+				s.IsSysmail.Reset()
+				if err := s.IsSysmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_sysmail\"")
+			}
+		case "is_catchall":
+			if err := func() error {
+				s.IsCatchall.Reset()
+				if err := s.IsCatchall.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_catchall\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchedMailUserRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PatchedMailUserRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PatchedMailUserRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PatchedSelectedToolVersionRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatchedSelectedToolVersionRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPatchedSelectedToolVersionRequest = [1]string{
+	0: "version",
+}
+
+// Decode decodes PatchedSelectedToolVersionRequest from json.
+func (s *PatchedSelectedToolVersionRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchedSelectedToolVersionRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchedSelectedToolVersionRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PatchedSelectedToolVersionRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PatchedSelectedToolVersionRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RefreshEvent) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RefreshEvent) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("model")
+		e.Str(s.Model)
+	}
+	{
+		e.FieldStart("target_host")
+		e.Str(s.TargetHost)
+	}
+	{
+		e.FieldStart("client")
+		e.Str(s.Client)
+	}
+	{
+		if s.JobPriority.Set {
+			e.FieldStart("job_priority")
+			s.JobPriority.Encode(e)
+		}
+	}
+}
 
-// Decode decodes PatchedExternalAsteroidRequest from json.
-func (s *PatchedExternalAsteroidRequest) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfRefreshEvent = [4]string{
+	0: "model",
+	1: "target_host",
+	2: "client",
+	3: "job_priority",
+}
+
+// Decode decodes RefreshEvent from json.
+func (s *RefreshEvent) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PatchedExternalAsteroidRequest to nil")
+		return errors.New("invalid: unable to decode RefreshEvent to nil")
 	}
+	var requiredBitSet [1]uint8
 	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "flag_log_error_php":
-			if err := func() error {
-				s.FlagLogErrorPhp.Reset()
-				if err := s.FlagLogErrorPhp.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"flag_log_error_php\"")
-			}
-		case "flag_log_error_apache":
-			if err := func() error {
-				s.FlagLogErrorApache.Reset()
-				if err := s.FlagLogErrorApache.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"flag_log_error_apache\"")
-			}
-		case "flag_log_access_nginx":
+		case "model":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.FlagLogAccessNginx.Reset()
-				if err := s.FlagLogAccessNginx.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Model = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"flag_log_access_nginx\"")
+				return errors.Wrap(err, "decode field \"model\"")
 			}
-		case "flag_page_replace_500":
+		case "target_host":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.FlagPageReplace500.Reset()
-				if err := s.FlagPageReplace500.Decode(d); err != nil {
+				v, err := d.Str()
+				s.TargetHost = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"flag_page_replace_500\"")
+				return errors.Wrap(err, "decode field \"target_host\"")
 			}
-		case "password":
+		case "client":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Password.Reset()
-				if err := s.Password.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Client = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"client\"")
 			}
-		case "password_hash":
+		case "job_priority":
 			if err := func() error {
-				s.PasswordHash.Reset()
-				if err := s.PasswordHash.Decode(d); err != nil {
+				s.JobPriority.Reset()
+				if err := s.JobPriority.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password_hash\"")
+				return errors.Wrap(err, "decode field \"job_priority\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PatchedExternalAsteroidRequest")
+		return errors.Wrap(err, "decode RefreshEvent")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRefreshEvent) {
+					name = jsonFieldsNameOfRefreshEvent[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PatchedExternalAsteroidRequest) MarshalJSON() ([]byte, error) {
+func (s *RefreshEvent) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PatchedExternalAsteroidRequest) UnmarshalJSON(data []byte) error {
+func (s *RefreshEvent) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PatchedMailUserRequest) Encode(e *jx.Encoder) {
+func (s *RefreshEventRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PatchedMailUserRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.PasswordHash.Set {
-			e.FieldStart("password_hash")
-			s.PasswordHash.Encode(e)
-		}
-	}
+func (s *RefreshEventRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.AliasOf.Set {
-			e.FieldStart("alias_of")
-			s.AliasOf.Encode(e)
-		}
+		e.FieldStart("model")
+		e.Str(s.Model)
 	}
 	{
-		if s.KeepForwards.Set {
-			e.FieldStart("keep_forwards")
-			s.KeepForwards.Encode(e)
-		}
+		e.FieldStart("target_host")
+		e.Str(s.TargetHost)
 	}
 	{
-		if s.IsSysmail.Set {
-			e.FieldStart("is_sysmail")
-			s.IsSysmail.Encode(e)
-		}
+		e.FieldStart("client")
+		e.Str(s.Client)
 	}
 	{
-		if s.IsCatchall.Set {
-			e.FieldStart("is_catchall")
-			s.IsCatchall.Encode(e)
+		if s.JobPriority.Set {
+			e.FieldStart("job_priority")
+			s.JobPriority.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPatchedMailUserRequest = [5]string{
-	0: "password_hash",
-	1: "alias_of",
-	2: "keep_forwards",
-	3: "is_sysmail",
-	4: "is_catchall",
+var jsonFieldsNameOfRefreshEventRequest = [4]string{
+	0: "model",
+	1: "target_host",
+	2: "client",
+	3: "job_priority",
 }
 
-// Decode decodes PatchedMailUserRequest from json.
-func (s *PatchedMailUserRequest) Decode(d *jx.Decoder) error {
+// Decode decodes RefreshEventRequest from json.
+func (s *RefreshEventRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PatchedMailUserRequest to nil")
+		return errors.New("invalid: unable to decode RefreshEventRequest to nil")
 	}
+	var requiredBitSet [1]uint8
 	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "password_hash":
-			if err := func() error {
-				s.PasswordHash.Reset()
-				if err := s.PasswordHash.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password_hash\"")
-			}
-		case "alias_of":
+		case "model":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.AliasOf.Reset()
-				if err := s.AliasOf.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Model = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"alias_of\"")
+				return errors.Wrap(err, "decode field \"model\"")
 			}
-		case "keep_forwards":
+		case "target_host":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.KeepForwards.Reset()
-				if err := s.KeepForwards.Decode(d); err != nil {
+				v, err := d.Str()
+				s.TargetHost = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"keep_forwards\"")
+				return errors.Wrap(err, "decode field \"target_host\"")
 			}
-		case "is_sysmail":
+		case "client":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.IsSysmail.Reset()
-				if err := s.IsSysmail.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Client = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_sysmail\"")
+				return errors.Wrap(err, "decode field \"client\"")
 			}
-		case "is_catchall":
+		case "job_priority":
 			if err := func() error {
-				s.IsCatchall.Reset()
-				if err := s.IsCatchall.Decode(d); err != nil {
+				s.JobPriority.Reset()
+				if err := s.JobPriority.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_catchall\"")
+				return errors.Wrap(err, "decode field \"job_priority\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PatchedMailUserRequest")
+		return errors.Wrap(err, "decode RefreshEventRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRefreshEventRequest) {
+					name = jsonFieldsNameOfRefreshEventRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PatchedMailUserRequest) MarshalJSON() ([]byte, error) {
+func (s *RefreshEventRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PatchedMailUserRequest) UnmarshalJSON(data []byte) error {
+func (s *RefreshEventRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *PatchedSelectedToolVersionRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes RefresheventsCreateApplicationJSON as json.
+func (s *RefresheventsCreateApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := (*RefreshEventRequest)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *PatchedSelectedToolVersionRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.Version.Set {
-			e.FieldStart("version")
-			s.Version.Encode(e)
+// Decode decodes RefresheventsCreateApplicationJSON from json.
+func (s *RefresheventsCreateApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefresheventsCreateApplicationJSON to nil")
+	}
+	var unwrapped RefreshEventRequest
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = RefresheventsCreateApplicationJSON(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfPatchedSelectedToolVersionRequest = [1]string{
-	0: "version",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefresheventsCreateApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes PatchedSelectedToolVersionRequest from json.
-func (s *PatchedSelectedToolVersionRequest) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefresheventsCreateApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RefresheventsCreateApplicationXWwwFormUrlencoded as json.
+func (s *RefresheventsCreateApplicationXWwwFormUrlencoded) Encode(e *jx.Encoder) {
+	unwrapped := (*RefreshEventRequest)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RefresheventsCreateApplicationXWwwFormUrlencoded from json.
+func (s *RefresheventsCreateApplicationXWwwFormUrlencoded) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PatchedSelectedToolVersionRequest to nil")
+		return errors.New("invalid: unable to decode RefresheventsCreateApplicationXWwwFormUrlencoded to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "version":
-			if err := func() error {
-				s.Version.Reset()
-				if err := s.Version.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"version\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped RefreshEventRequest
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode PatchedSelectedToolVersionRequest")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = RefresheventsCreateApplicationXWwwFormUrlencoded(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PatchedSelectedToolVersionRequest) MarshalJSON() ([]byte, error) {
+func (s *RefresheventsCreateApplicationXWwwFormUrlencoded) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PatchedSelectedToolVersionRequest) UnmarshalJSON(data []byte) error {
+func (s *RefresheventsCreateApplicationXWwwFormUrlencoded) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
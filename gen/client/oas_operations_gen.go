@@ -45,6 +45,10 @@ const (
 	AsteroidsWebdomainsListOperation                 OperationName = "AsteroidsWebdomainsList"
 	AsteroidsWebheadersGetOperation                  OperationName = "AsteroidsWebheadersGet"
 	AsteroidsWebheadersListOperation                 OperationName = "AsteroidsWebheadersList"
+	EventsGetOperation                               OperationName = "EventsGet"
+	EventsListOperation                              OperationName = "EventsList"
+	EventsLogsListOperation                          OperationName = "EventsLogsList"
+	RefresheventsCreateOperation                     OperationName = "RefresheventsCreate"
 	ToolsGetOperation                                OperationName = "ToolsGet"
 	ToolsListOperation                               OperationName = "ToolsList"
 	ToolsVersionsGetOperation                        OperationName = "ToolsVersionsGet"
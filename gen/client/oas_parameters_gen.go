@@ -323,6 +323,37 @@ type AsteroidsWebheadersListParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
+// EventsGetParams is parameters of events_get operation.
+type EventsGetParams struct {
+	Format OptEventsGetFormat `json:",omitempty,omitzero"`
+	// A unique integer value identifying this event.
+	ID int
+}
+
+// EventsListParams is parameters of events_list operation.
+type EventsListParams struct {
+	Format OptEventsListFormat `json:",omitempty,omitzero"`
+	// Number of results to return per page.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// The initial index from which to return the results.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+// EventsLogsListParams is parameters of events_logs_list operation.
+type EventsLogsListParams struct {
+	EventPk int
+	Format  OptEventsLogsListFormat `json:",omitempty,omitzero"`
+	// Number of results to return per page.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// The initial index from which to return the results.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+// RefresheventsCreateParams is parameters of refreshevents_create operation.
+type RefresheventsCreateParams struct {
+	Format OptRefresheventsCreateFormat `json:",omitempty,omitzero"`
+}
+
 // ToolsGetParams is parameters of tools_get operation.
 type ToolsGetParams struct {
 	Format OptToolsGetFormat `json:",omitempty,omitzero"`
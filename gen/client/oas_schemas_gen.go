@@ -1727,9 +1727,71 @@ func (s *AsteroidsWebheadersListFormat) UnmarshalText(data []byte) error {
 	}
 }
 
-// * `APACHE` - Apache
-// * `STATIC` - Static
-// * `PORT` - Port.
+//   - `DELETED` - deleted
+//   - `CHANGED` - changed
+//   - `CREATED` - created
+//   - `REFRESH` - refresh
+//
+// Ref: #/components/schemas/CauseEnum
+type CauseEnum string
+
+const (
+	CauseEnumDELETED CauseEnum = "DELETED"
+	CauseEnumCHANGED CauseEnum = "CHANGED"
+	CauseEnumCREATED CauseEnum = "CREATED"
+	CauseEnumREFRESH CauseEnum = "REFRESH"
+)
+
+// AllValues returns all CauseEnum values.
+func (CauseEnum) AllValues() []CauseEnum {
+	return []CauseEnum{
+		CauseEnumDELETED,
+		CauseEnumCHANGED,
+		CauseEnumCREATED,
+		CauseEnumREFRESH,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CauseEnum) MarshalText() ([]byte, error) {
+	switch s {
+	case CauseEnumDELETED:
+		return []byte(s), nil
+	case CauseEnumCHANGED:
+		return []byte(s), nil
+	case CauseEnumCREATED:
+		return []byte(s), nil
+	case CauseEnumREFRESH:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CauseEnum) UnmarshalText(data []byte) error {
+	switch CauseEnum(data) {
+	case CauseEnumDELETED:
+		*s = CauseEnumDELETED
+		return nil
+	case CauseEnumCHANGED:
+		*s = CauseEnumCHANGED
+		return nil
+	case CauseEnumCREATED:
+		*s = CauseEnumCREATED
+		return nil
+	case CauseEnumREFRESH:
+		*s = CauseEnumREFRESH
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+//   - `APACHE` - Apache
+//   - `STATIC` - Static
+//   - `PORT` - Port
+//
 // Ref: #/components/schemas/DestinationEnum
 type DestinationEnum string
 
@@ -1779,11 +1841,12 @@ func (s *DestinationEnum) UnmarshalText(data []byte) error {
 	}
 }
 
-// * `VALID` - valid
-// * `INVALID` - invalid, could check, but invalid result
-// * `ERROR` - error, could not check
-// * `UNCHECKED` - unchecked, did not check yet
-// * `IGNORED` - ignored, do not check.
+//   - `VALID` - valid
+//   - `INVALID` - invalid, could check, but invalid result
+//   - `ERROR` - error, could not check
+//   - `UNCHECKED` - unchecked, did not check yet
+//   - `IGNORED` - ignored, do not check
+//
 // Ref: #/components/schemas/DnsStateEnum
 type DnsStateEnum string
 
@@ -1847,6 +1910,284 @@ func (s *DnsStateEnum) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/Event
+type Event struct {
+	Pk              int            `json:"pk"`
+	CreatedAt       time.Time      `json:"created_at"`
+	ContentType     string         `json:"content_type"`
+	ObjectID        NilInt         `json:"object_id"`
+	ObjectName      NilString      `json:"object_name"`
+	HumanIdentifier string         `json:"human_identifier"`
+	Cause           CauseEnum      `json:"cause"`
+	State           EventStateEnum `json:"state"`
+}
+
+// GetPk returns the value of Pk.
+func (s *Event) GetPk() int {
+	return s.Pk
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Event) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetContentType returns the value of ContentType.
+func (s *Event) GetContentType() string {
+	return s.ContentType
+}
+
+// GetObjectID returns the value of ObjectID.
+func (s *Event) GetObjectID() NilInt {
+	return s.ObjectID
+}
+
+// GetObjectName returns the value of ObjectName.
+func (s *Event) GetObjectName() NilString {
+	return s.ObjectName
+}
+
+// GetHumanIdentifier returns the value of HumanIdentifier.
+func (s *Event) GetHumanIdentifier() string {
+	return s.HumanIdentifier
+}
+
+// GetCause returns the value of Cause.
+func (s *Event) GetCause() CauseEnum {
+	return s.Cause
+}
+
+// GetState returns the value of State.
+func (s *Event) GetState() EventStateEnum {
+	return s.State
+}
+
+// SetPk sets the value of Pk.
+func (s *Event) SetPk(val int) {
+	s.Pk = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Event) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *Event) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetObjectID sets the value of ObjectID.
+func (s *Event) SetObjectID(val NilInt) {
+	s.ObjectID = val
+}
+
+// SetObjectName sets the value of ObjectName.
+func (s *Event) SetObjectName(val NilString) {
+	s.ObjectName = val
+}
+
+// SetHumanIdentifier sets the value of HumanIdentifier.
+func (s *Event) SetHumanIdentifier(val string) {
+	s.HumanIdentifier = val
+}
+
+// SetCause sets the value of Cause.
+func (s *Event) SetCause(val CauseEnum) {
+	s.Cause = val
+}
+
+// SetState sets the value of State.
+func (s *Event) SetState(val EventStateEnum) {
+	s.State = val
+}
+
+// Ref: #/components/schemas/EventStateEnum
+type EventStateEnum string
+
+const (
+	EventStateEnumPENDING EventStateEnum = "PENDING"
+	EventStateEnumRUNNING EventStateEnum = "RUNNING"
+	EventStateEnumFAILED  EventStateEnum = "FAILED"
+	EventStateEnumSKIPPED EventStateEnum = "SKIPPED"
+	EventStateEnumDONE    EventStateEnum = "DONE"
+)
+
+// AllValues returns all EventStateEnum values.
+func (EventStateEnum) AllValues() []EventStateEnum {
+	return []EventStateEnum{
+		EventStateEnumPENDING,
+		EventStateEnumRUNNING,
+		EventStateEnumFAILED,
+		EventStateEnumSKIPPED,
+		EventStateEnumDONE,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EventStateEnum) MarshalText() ([]byte, error) {
+	switch s {
+	case EventStateEnumPENDING:
+		return []byte(s), nil
+	case EventStateEnumRUNNING:
+		return []byte(s), nil
+	case EventStateEnumFAILED:
+		return []byte(s), nil
+	case EventStateEnumSKIPPED:
+		return []byte(s), nil
+	case EventStateEnumDONE:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EventStateEnum) UnmarshalText(data []byte) error {
+	switch EventStateEnum(data) {
+	case EventStateEnumPENDING:
+		*s = EventStateEnumPENDING
+		return nil
+	case EventStateEnumRUNNING:
+		*s = EventStateEnumRUNNING
+		return nil
+	case EventStateEnumFAILED:
+		*s = EventStateEnumFAILED
+		return nil
+	case EventStateEnumSKIPPED:
+		*s = EventStateEnumSKIPPED
+		return nil
+	case EventStateEnumDONE:
+		*s = EventStateEnumDONE
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type EventsGetFormat string
+
+const (
+	EventsGetFormatJSON            EventsGetFormat = "json"
+	EventsGetFormatTextEventStream EventsGetFormat = "text/event-stream"
+)
+
+// AllValues returns all EventsGetFormat values.
+func (EventsGetFormat) AllValues() []EventsGetFormat {
+	return []EventsGetFormat{
+		EventsGetFormatJSON,
+		EventsGetFormatTextEventStream,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EventsGetFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case EventsGetFormatJSON:
+		return []byte(s), nil
+	case EventsGetFormatTextEventStream:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EventsGetFormat) UnmarshalText(data []byte) error {
+	switch EventsGetFormat(data) {
+	case EventsGetFormatJSON:
+		*s = EventsGetFormatJSON
+		return nil
+	case EventsGetFormatTextEventStream:
+		*s = EventsGetFormatTextEventStream
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type EventsListFormat string
+
+const (
+	EventsListFormatJSON            EventsListFormat = "json"
+	EventsListFormatTextEventStream EventsListFormat = "text/event-stream"
+)
+
+// AllValues returns all EventsListFormat values.
+func (EventsListFormat) AllValues() []EventsListFormat {
+	return []EventsListFormat{
+		EventsListFormatJSON,
+		EventsListFormatTextEventStream,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EventsListFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case EventsListFormatJSON:
+		return []byte(s), nil
+	case EventsListFormatTextEventStream:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EventsListFormat) UnmarshalText(data []byte) error {
+	switch EventsListFormat(data) {
+	case EventsListFormatJSON:
+		*s = EventsListFormatJSON
+		return nil
+	case EventsListFormatTextEventStream:
+		*s = EventsListFormatTextEventStream
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type EventsLogsListFormat string
+
+const (
+	EventsLogsListFormatJSON            EventsLogsListFormat = "json"
+	EventsLogsListFormatTextEventStream EventsLogsListFormat = "text/event-stream"
+)
+
+// AllValues returns all EventsLogsListFormat values.
+func (EventsLogsListFormat) AllValues() []EventsLogsListFormat {
+	return []EventsLogsListFormat{
+		EventsLogsListFormatJSON,
+		EventsLogsListFormatTextEventStream,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EventsLogsListFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case EventsLogsListFormatJSON:
+		return []byte(s), nil
+	case EventsLogsListFormatTextEventStream:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EventsLogsListFormat) UnmarshalText(data []byte) error {
+	switch EventsLogsListFormat(data) {
+	case EventsLogsListFormatJSON:
+		*s = EventsLogsListFormatJSON
+		return nil
+	case EventsLogsListFormatTextEventStream:
+		*s = EventsLogsListFormatTextEventStream
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/ExternalAsteroid
 type ExternalAsteroid struct {
 	Pk string `json:"pk"`
@@ -1975,13 +2316,84 @@ func (s *ExternalAsteroid) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
-// * `sk-ecdsa-sha2-nistp256@openssh.com` - sk-ecdsa-sha2-nistp256@openssh.com
-// * `ecdsa-sha2-nistp256` - ecdsa-sha2-nistp256
-// * `ecdsa-sha2-nistp384` - ecdsa-sha2-nistp384
-// * `ecdsa-sha2-nistp521` - ecdsa-sha2-nistp521
-// * `sk-ssh-ed25519@openssh.com` - sk-ssh-ed25519@openssh.com
-// * `ssh-ed25519` - ssh-ed25519
-// * `ssh-rsa` - ssh-rsa.
+// Ref: #/components/schemas/JobLog
+type JobLog struct {
+	CreatedAt  time.Time      `json:"created_at"`
+	Job        int            `json:"job"`
+	StateOld   EventStateEnum `json:"state_old"`
+	StateNew   EventStateEnum `json:"state_new"`
+	Message    string         `json:"message"`
+	ClientName string         `json:"client_name"`
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *JobLog) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetJob returns the value of Job.
+func (s *JobLog) GetJob() int {
+	return s.Job
+}
+
+// GetStateOld returns the value of StateOld.
+func (s *JobLog) GetStateOld() EventStateEnum {
+	return s.StateOld
+}
+
+// GetStateNew returns the value of StateNew.
+func (s *JobLog) GetStateNew() EventStateEnum {
+	return s.StateNew
+}
+
+// GetMessage returns the value of Message.
+func (s *JobLog) GetMessage() string {
+	return s.Message
+}
+
+// GetClientName returns the value of ClientName.
+func (s *JobLog) GetClientName() string {
+	return s.ClientName
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *JobLog) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetJob sets the value of Job.
+func (s *JobLog) SetJob(val int) {
+	s.Job = val
+}
+
+// SetStateOld sets the value of StateOld.
+func (s *JobLog) SetStateOld(val EventStateEnum) {
+	s.StateOld = val
+}
+
+// SetStateNew sets the value of StateNew.
+func (s *JobLog) SetStateNew(val EventStateEnum) {
+	s.StateNew = val
+}
+
+// SetMessage sets the value of Message.
+func (s *JobLog) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetClientName sets the value of ClientName.
+func (s *JobLog) SetClientName(val string) {
+	s.ClientName = val
+}
+
+//   - `sk-ecdsa-sha2-nistp256@openssh.com` - sk-ecdsa-sha2-nistp256@openssh.com
+//   - `ecdsa-sha2-nistp256` - ecdsa-sha2-nistp256
+//   - `ecdsa-sha2-nistp384` - ecdsa-sha2-nistp384
+//   - `ecdsa-sha2-nistp521` - ecdsa-sha2-nistp521
+//   - `sk-ssh-ed25519@openssh.com` - sk-ssh-ed25519@openssh.com
+//   - `ssh-ed25519` - ssh-ed25519
+//   - `ssh-rsa` - ssh-rsa
+//
 // Ref: #/components/schemas/KeyTypeEnum
 type KeyTypeEnum string
 
@@ -2659,6 +3071,51 @@ func (o NilDateTime) Or(d time.Time) time.Time {
 	return d
 }
 
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
+		Value: v,
+	}
+}
+
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt) SetTo(v int) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt) SetToNull() {
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewNilString returns new NilString with value set to v.
 func NewNilString(v string) NilString {
 	return NilString{
@@ -4544,38 +5001,38 @@ func (o OptBool) Or(d bool) bool {
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptEventsGetFormat returns new OptEventsGetFormat with value set to v.
+func NewOptEventsGetFormat(v EventsGetFormat) OptEventsGetFormat {
+	return OptEventsGetFormat{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptEventsGetFormat is optional EventsGetFormat.
+type OptEventsGetFormat struct {
+	Value EventsGetFormat
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptEventsGetFormat was set.
+func (o OptEventsGetFormat) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptEventsGetFormat) Reset() {
+	var v EventsGetFormat
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptEventsGetFormat) SetTo(v EventsGetFormat) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptEventsGetFormat) Get() (v EventsGetFormat, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4583,24 +5040,162 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptEventsGetFormat) Or(d EventsGetFormat) EventsGetFormat {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilInt returns new OptNilInt with value set to v.
-func NewOptNilInt(v int) OptNilInt {
-	return OptNilInt{
+// NewOptEventsListFormat returns new OptEventsListFormat with value set to v.
+func NewOptEventsListFormat(v EventsListFormat) OptEventsListFormat {
+	return OptEventsListFormat{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilInt is optional nullable int.
-type OptNilInt struct {
-	Value int
+// OptEventsListFormat is optional EventsListFormat.
+type OptEventsListFormat struct {
+	Value EventsListFormat
+	Set   bool
+}
+
+// IsSet returns true if OptEventsListFormat was set.
+func (o OptEventsListFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventsListFormat) Reset() {
+	var v EventsListFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventsListFormat) SetTo(v EventsListFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventsListFormat) Get() (v EventsListFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventsListFormat) Or(d EventsListFormat) EventsListFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventsLogsListFormat returns new OptEventsLogsListFormat with value set to v.
+func NewOptEventsLogsListFormat(v EventsLogsListFormat) OptEventsLogsListFormat {
+	return OptEventsLogsListFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventsLogsListFormat is optional EventsLogsListFormat.
+type OptEventsLogsListFormat struct {
+	Value EventsLogsListFormat
+	Set   bool
+}
+
+// IsSet returns true if OptEventsLogsListFormat was set.
+func (o OptEventsLogsListFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventsLogsListFormat) Reset() {
+	var v EventsLogsListFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventsLogsListFormat) SetTo(v EventsLogsListFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventsLogsListFormat) Get() (v EventsLogsListFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventsLogsListFormat) Or(d EventsLogsListFormat) EventsLogsListFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
 	Set   bool
 	Null  bool
 }
@@ -4634,6 +5229,11 @@ func (o *OptNilInt) SetToNull() {
 	o.Value = v
 }
 
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilInt) IsEmpty() bool {
+	return !o.Set && !o.Null
+}
+
 // Get returns value and boolean that denotes whether value was set.
 func (o OptNilInt) Get() (v int, ok bool) {
 	if o.Null {
@@ -4697,6 +5297,11 @@ func (o *OptNilString) SetToNull() {
 	o.Value = v
 }
 
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilString) IsEmpty() bool {
+	return !o.Set && !o.Null
+}
+
 // Get returns value and boolean that denotes whether value was set.
 func (o OptNilString) Get() (v string, ok bool) {
 	if o.Null {
@@ -4760,6 +5365,11 @@ func (o *OptNilURI) SetToNull() {
 	o.Value = v
 }
 
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilURI) IsEmpty() bool {
+	return !o.Set && !o.Null
+}
+
 // Get returns value and boolean that denotes whether value was set.
 func (o OptNilURI) Get() (v url.URL, ok bool) {
 	if o.Null {
@@ -4779,6 +5389,52 @@ func (o OptNilURI) Or(d url.URL) url.URL {
 	return d
 }
 
+// NewOptRefresheventsCreateFormat returns new OptRefresheventsCreateFormat with value set to v.
+func NewOptRefresheventsCreateFormat(v RefresheventsCreateFormat) OptRefresheventsCreateFormat {
+	return OptRefresheventsCreateFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRefresheventsCreateFormat is optional RefresheventsCreateFormat.
+type OptRefresheventsCreateFormat struct {
+	Value RefresheventsCreateFormat
+	Set   bool
+}
+
+// IsSet returns true if OptRefresheventsCreateFormat was set.
+func (o OptRefresheventsCreateFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRefresheventsCreateFormat) Reset() {
+	var v RefresheventsCreateFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRefresheventsCreateFormat) SetTo(v RefresheventsCreateFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRefresheventsCreateFormat) Get() (v RefresheventsCreateFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRefresheventsCreateFormat) Or(d RefresheventsCreateFormat) RefresheventsCreateFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptRelatedMailDomainField returns new OptRelatedMailDomainField with value set to v.
 func NewOptRelatedMailDomainField(v RelatedMailDomainField) OptRelatedMailDomainField {
 	return OptRelatedMailDomainField{
@@ -5147,6 +5803,102 @@ func (o OptToolsVersionsListFormat) Or(d ToolsVersionsListFormat) ToolsVersionsL
 	return d
 }
 
+// Ref: #/components/schemas/PaginatedEventList
+type PaginatedEventList struct {
+	Count    int       `json:"count"`
+	Next     OptNilURI `json:"next"`
+	Previous OptNilURI `json:"previous"`
+	Results  []Event   `json:"results"`
+}
+
+// GetCount returns the value of Count.
+func (s *PaginatedEventList) GetCount() int {
+	return s.Count
+}
+
+// GetNext returns the value of Next.
+func (s *PaginatedEventList) GetNext() OptNilURI {
+	return s.Next
+}
+
+// GetPrevious returns the value of Previous.
+func (s *PaginatedEventList) GetPrevious() OptNilURI {
+	return s.Previous
+}
+
+// GetResults returns the value of Results.
+func (s *PaginatedEventList) GetResults() []Event {
+	return s.Results
+}
+
+// SetCount sets the value of Count.
+func (s *PaginatedEventList) SetCount(val int) {
+	s.Count = val
+}
+
+// SetNext sets the value of Next.
+func (s *PaginatedEventList) SetNext(val OptNilURI) {
+	s.Next = val
+}
+
+// SetPrevious sets the value of Previous.
+func (s *PaginatedEventList) SetPrevious(val OptNilURI) {
+	s.Previous = val
+}
+
+// SetResults sets the value of Results.
+func (s *PaginatedEventList) SetResults(val []Event) {
+	s.Results = val
+}
+
+// Ref: #/components/schemas/PaginatedJobLogList
+type PaginatedJobLogList struct {
+	Count    int       `json:"count"`
+	Next     OptNilURI `json:"next"`
+	Previous OptNilURI `json:"previous"`
+	Results  []JobLog  `json:"results"`
+}
+
+// GetCount returns the value of Count.
+func (s *PaginatedJobLogList) GetCount() int {
+	return s.Count
+}
+
+// GetNext returns the value of Next.
+func (s *PaginatedJobLogList) GetNext() OptNilURI {
+	return s.Next
+}
+
+// GetPrevious returns the value of Previous.
+func (s *PaginatedJobLogList) GetPrevious() OptNilURI {
+	return s.Previous
+}
+
+// GetResults returns the value of Results.
+func (s *PaginatedJobLogList) GetResults() []JobLog {
+	return s.Results
+}
+
+// SetCount sets the value of Count.
+func (s *PaginatedJobLogList) SetCount(val int) {
+	s.Count = val
+}
+
+// SetNext sets the value of Next.
+func (s *PaginatedJobLogList) SetNext(val OptNilURI) {
+	s.Next = val
+}
+
+// SetPrevious sets the value of Previous.
+func (s *PaginatedJobLogList) SetPrevious(val OptNilURI) {
+	s.Previous = val
+}
+
+// SetResults sets the value of Results.
+func (s *PaginatedJobLogList) SetResults(val []JobLog) {
+	s.Results = val
+}
+
 // Ref: #/components/schemas/PaginatedMailDomainList
 type PaginatedMailDomainList struct {
 	Count    int          `json:"count"`
@@ -5929,6 +6681,204 @@ func (s *PatchedSelectedToolVersionRequestMultipart) SetVersion(val OptString) {
 
 func (*PatchedSelectedToolVersionRequestMultipart) asteroidsToolversionsPatchReq() {}
 
+// Ref: #/components/schemas/RefreshEvent
+type RefreshEvent struct {
+	Model string `json:"model"`
+	// Hostname of a server, e.g. 'tuttle'.
+	TargetHost  string `json:"target_host"`
+	Client      string `json:"client"`
+	JobPriority OptInt `json:"job_priority"`
+}
+
+// GetModel returns the value of Model.
+func (s *RefreshEvent) GetModel() string {
+	return s.Model
+}
+
+// GetTargetHost returns the value of TargetHost.
+func (s *RefreshEvent) GetTargetHost() string {
+	return s.TargetHost
+}
+
+// GetClient returns the value of Client.
+func (s *RefreshEvent) GetClient() string {
+	return s.Client
+}
+
+// GetJobPriority returns the value of JobPriority.
+func (s *RefreshEvent) GetJobPriority() OptInt {
+	return s.JobPriority
+}
+
+// SetModel sets the value of Model.
+func (s *RefreshEvent) SetModel(val string) {
+	s.Model = val
+}
+
+// SetTargetHost sets the value of TargetHost.
+func (s *RefreshEvent) SetTargetHost(val string) {
+	s.TargetHost = val
+}
+
+// SetClient sets the value of Client.
+func (s *RefreshEvent) SetClient(val string) {
+	s.Client = val
+}
+
+// SetJobPriority sets the value of JobPriority.
+func (s *RefreshEvent) SetJobPriority(val OptInt) {
+	s.JobPriority = val
+}
+
+// Ref: #/components/schemas/RefreshEventRequest
+type RefreshEventRequest struct {
+	Model string `json:"model"`
+	// Hostname of a server, e.g. 'tuttle'.
+	TargetHost  string `json:"target_host"`
+	Client      string `json:"client"`
+	JobPriority OptInt `json:"job_priority"`
+}
+
+// GetModel returns the value of Model.
+func (s *RefreshEventRequest) GetModel() string {
+	return s.Model
+}
+
+// GetTargetHost returns the value of TargetHost.
+func (s *RefreshEventRequest) GetTargetHost() string {
+	return s.TargetHost
+}
+
+// GetClient returns the value of Client.
+func (s *RefreshEventRequest) GetClient() string {
+	return s.Client
+}
+
+// GetJobPriority returns the value of JobPriority.
+func (s *RefreshEventRequest) GetJobPriority() OptInt {
+	return s.JobPriority
+}
+
+// SetModel sets the value of Model.
+func (s *RefreshEventRequest) SetModel(val string) {
+	s.Model = val
+}
+
+// SetTargetHost sets the value of TargetHost.
+func (s *RefreshEventRequest) SetTargetHost(val string) {
+	s.TargetHost = val
+}
+
+// SetClient sets the value of Client.
+func (s *RefreshEventRequest) SetClient(val string) {
+	s.Client = val
+}
+
+// SetJobPriority sets the value of JobPriority.
+func (s *RefreshEventRequest) SetJobPriority(val OptInt) {
+	s.JobPriority = val
+}
+
+// Ref: #/components/schemas/RefreshEventRequest
+type RefreshEventRequestMultipart struct {
+	Model string `json:"model"`
+	// Hostname of a server, e.g. 'tuttle'.
+	TargetHost  string `json:"target_host"`
+	Client      string `json:"client"`
+	JobPriority OptInt `json:"job_priority"`
+}
+
+// GetModel returns the value of Model.
+func (s *RefreshEventRequestMultipart) GetModel() string {
+	return s.Model
+}
+
+// GetTargetHost returns the value of TargetHost.
+func (s *RefreshEventRequestMultipart) GetTargetHost() string {
+	return s.TargetHost
+}
+
+// GetClient returns the value of Client.
+func (s *RefreshEventRequestMultipart) GetClient() string {
+	return s.Client
+}
+
+// GetJobPriority returns the value of JobPriority.
+func (s *RefreshEventRequestMultipart) GetJobPriority() OptInt {
+	return s.JobPriority
+}
+
+// SetModel sets the value of Model.
+func (s *RefreshEventRequestMultipart) SetModel(val string) {
+	s.Model = val
+}
+
+// SetTargetHost sets the value of TargetHost.
+func (s *RefreshEventRequestMultipart) SetTargetHost(val string) {
+	s.TargetHost = val
+}
+
+// SetClient sets the value of Client.
+func (s *RefreshEventRequestMultipart) SetClient(val string) {
+	s.Client = val
+}
+
+// SetJobPriority sets the value of JobPriority.
+func (s *RefreshEventRequestMultipart) SetJobPriority(val OptInt) {
+	s.JobPriority = val
+}
+
+func (*RefreshEventRequestMultipart) refresheventsCreateReq() {}
+
+type RefresheventsCreateApplicationJSON RefreshEventRequest
+
+func (*RefresheventsCreateApplicationJSON) refresheventsCreateReq() {}
+
+type RefresheventsCreateApplicationXWwwFormUrlencoded RefreshEventRequest
+
+func (*RefresheventsCreateApplicationXWwwFormUrlencoded) refresheventsCreateReq() {}
+
+type RefresheventsCreateFormat string
+
+const (
+	RefresheventsCreateFormatJSON            RefresheventsCreateFormat = "json"
+	RefresheventsCreateFormatTextEventStream RefresheventsCreateFormat = "text/event-stream"
+)
+
+// AllValues returns all RefresheventsCreateFormat values.
+func (RefresheventsCreateFormat) AllValues() []RefresheventsCreateFormat {
+	return []RefresheventsCreateFormat{
+		RefresheventsCreateFormatJSON,
+		RefresheventsCreateFormatTextEventStream,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RefresheventsCreateFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case RefresheventsCreateFormatJSON:
+		return []byte(s), nil
+	case RefresheventsCreateFormatTextEventStream:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RefresheventsCreateFormat) UnmarshalText(data []byte) error {
+	switch RefresheventsCreateFormat(data) {
+	case RefresheventsCreateFormatJSON:
+		*s = RefresheventsCreateFormatJSON
+		return nil
+	case RefresheventsCreateFormatTextEventStream:
+		*s = RefresheventsCreateFormatTextEventStream
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/RelatedMailDomainField
 type RelatedMailDomainField struct {
 	Pk       string                  `json:"pk"`
@@ -66,8 +66,17 @@ cat openapi_tmp_out.json > openapi_tmp_in.json
 sed 's/\\\\u00fc/ü/g; s/\\\\u00e4/ä/g; s/\\\\u00f6/ö/g; s/\\\\u00dc/Ü/g; s/\\\\u00c4/Ä/g; s/\\\\u00d6/Ö/g; s/\\\\u00df/ß/g' openapi_tmp_in.json > openapi_tmp_out.json
 cat openapi_tmp_out.json > openapi_tmp_in.json
 
-# remove api_v1_external_ prefix from all operationIds
-sed 's/api_v1_external_//g' openapi_tmp_in.json > openapi_tmp_out.json
+# remove text/event-stream responses, the provider only consumes application/json
+jq 'walk(
+  if type == "object" and has("text/event-stream")
+  then del(.["text/event-stream"])
+  else .
+  end
+)' openapi_tmp_in.json > openapi_tmp_out.json
+cat openapi_tmp_out.json > openapi_tmp_in.json
+
+# remove api_v1_external_ and api_v1_common_ prefixes from all operationIds
+sed 's/api_v1_external_//g; s/api_v1_common_//g' openapi_tmp_in.json > openapi_tmp_out.json
 cat openapi_tmp_out.json > openapi_tmp_in.json
 
 # save the final cleaned up openapi.json
@@ -0,0 +1,215 @@
+// Package mailpassword generates mailbox passwords and hashes them in the
+// SHA512-CRYPT format accepted as password_hash by the Uberspace API.
+package mailpassword
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxSaltLength is the number of salt characters used by SHA512-CRYPT,
+	// longer salts are rejected.
+	MaxSaltLength = 16
+
+	// rounds is the default number of rounds of SHA512-CRYPT, it is not
+	// written to the hash.
+	rounds = 5000
+
+	// alphabet is the crypt base64 alphabet, used for salts and hashes.
+	alphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	// passwordAlphabet contains the characters of generated passwords. It
+	// leaves out characters that need quoting in mail client configs.
+	passwordAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// Generate returns a random password of the given length.
+func Generate(length int) (string, error) {
+	if length < 1 {
+		return "", errors.New("password length must be positive")
+	}
+
+	return random(passwordAlphabet, length), nil
+}
+
+// NewSalt returns a random salt of MaxSaltLength characters.
+func NewSalt() string {
+	return random(alphabet, MaxSaltLength)
+}
+
+// random returns n characters of chars chosen uniformly by crypto/rand.
+func random(chars string, n int) string {
+	// the largest multiple of len(chars) up to 256, bytes above are
+	// discarded to avoid a bias towards the first characters
+	limit := 256 / len(chars) * len(chars)
+
+	out := make([]byte, 0, n)
+	buf := make([]byte, n)
+
+	for len(out) < n {
+		// crypto/rand.Read never returns an error
+		_, _ = rand.Read(buf)
+
+		for _, b := range buf {
+			if len(out) == n {
+				break
+			}
+
+			if int(b) >= limit {
+				continue
+			}
+
+			out = append(out, chars[int(b)%len(chars)])
+		}
+	}
+
+	return string(out)
+}
+
+// Hash returns the SHA512-CRYPT hash "$6$<salt>$<hash>" of the password. The
+// salt must consist of 1 to 16 characters of [./0-9A-Za-z].
+func Hash(password, salt string) (string, error) {
+	if err := validateSalt(salt); err != nil {
+		return "", err
+	}
+
+	sum := sha512Crypt([]byte(password), []byte(salt))
+
+	return "$6$" + salt + "$" + encode(sum), nil
+}
+
+func validateSalt(salt string) error {
+	if salt == "" {
+		return errors.New("salt must not be empty")
+	}
+
+	if len(salt) > MaxSaltLength {
+		return fmt.Errorf("salt must not be longer than %d characters", MaxSaltLength)
+	}
+
+	for _, c := range salt {
+		if !strings.ContainsRune(alphabet, c) {
+			return fmt.Errorf("salt contains invalid character %q, only [./0-9A-Za-z] are allowed", c)
+		}
+	}
+
+	return nil
+}
+
+// sha512Crypt implements the SHA-512 based crypt algorithm by Ulrich Drepper,
+// see https://www.akkadia.org/drepper/SHA-crypt.txt. The step numbers refer to
+// that specification.
+func sha512Crypt(password, salt []byte) []byte {
+	// steps 4-8
+	b := sha512.New()
+	b.Write(password)
+	b.Write(salt)
+	b.Write(password)
+	sumB := b.Sum(nil)
+
+	// steps 1-3, 9-12
+	a := sha512.New()
+	a.Write(password)
+	a.Write(salt)
+	a.Write(repeat(sumB, len(password)))
+
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			a.Write(sumB)
+		} else {
+			a.Write(password)
+		}
+	}
+
+	sumA := a.Sum(nil)
+
+	// steps 13-16
+	dp := sha512.New()
+	for range password {
+		dp.Write(password)
+	}
+
+	p := repeat(dp.Sum(nil), len(password))
+
+	// steps 17-20
+	ds := sha512.New()
+	for range 16 + int(sumA[0]) {
+		ds.Write(salt)
+	}
+
+	s := repeat(ds.Sum(nil), len(salt))
+
+	// step 21
+	sumC := sumA
+
+	for i := range rounds {
+		c := sha512.New()
+
+		if i&1 != 0 {
+			c.Write(p)
+		} else {
+			c.Write(sumC)
+		}
+
+		if i%3 != 0 {
+			c.Write(s)
+		}
+
+		if i%7 != 0 {
+			c.Write(p)
+		}
+
+		if i&1 != 0 {
+			c.Write(sumC)
+		} else {
+			c.Write(p)
+		}
+
+		sumC = c.Sum(nil)
+	}
+
+	return sumC
+}
+
+// repeat returns the first n bytes of sum repeated as often as needed.
+func repeat(sum []byte, n int) []byte {
+	out := make([]byte, 0, n)
+
+	for len(out) < n {
+		out = append(out, sum[:min(n-len(out), len(sum))]...)
+	}
+
+	return out
+}
+
+// encode writes the 64 bytes of the digest in the byte order of step 22.
+func encode(sum []byte) string {
+	var b strings.Builder
+
+	for i := range 21 {
+		group := [3]int{i, i + 21, i + 42}
+		// the group is rotated by one position for every group
+		for range i % 3 {
+			group = [3]int{group[1], group[2], group[0]}
+		}
+
+		write24(&b, sum[group[0]], sum[group[1]], sum[group[2]], 4)
+	}
+
+	write24(&b, 0, 0, sum[63], 2)
+
+	return b.String()
+}
+
+func write24(b *strings.Builder, b2, b1, b0 byte, n int) {
+	w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+
+	for range n {
+		b.WriteByte(alphabet[w&0x3f])
+		w >>= 6
+	}
+}
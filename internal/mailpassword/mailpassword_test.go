@@ -0,0 +1,95 @@
+package mailpassword
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	// hashes verified with crypt(3) of glibc
+	tests := []struct {
+		password string
+		salt     string
+		want     string
+	}{
+		{
+			password: "Hello world!",
+			salt:     "saltstring",
+			want:     "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1",
+		},
+		{
+			password: "",
+			salt:     "saltstring",
+			want:     "$6$saltstring$kyGrqt6gmjAdtFLPrflEFifSYLCWWq1pyx95SvqinLDy2UHmj0sTF0MSLMwxPFZc3tu5kQckI8fks0zOPda3n1",
+		},
+		{
+			password: strings.Repeat("we have a short salt string but not a short password, ", 2)[:106],
+			salt:     "short",
+			want:     "$6$short$e1oHhSrG8DKqMpbT2xwciLh1OZuuUj20r2SMEoOBP25pjirrFpELYGwhKa6t8bvNgG1hhgYGsRE1iuK9VfX4Z/",
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Hash(tt.password, tt.salt)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Errorf("Hash(%q, %q) = %q, want %q", tt.password, tt.salt, got, tt.want)
+		}
+	}
+}
+
+func TestHashInvalidSalt(t *testing.T) {
+	for salt, want := range map[string]string{
+		"":                  "empty",
+		"saltstringsaltstr": "longer",
+		"salt$":             "invalid character",
+	} {
+		if _, err := Hash("password", salt); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Hash with salt %q: got error %v, want %q", salt, err, want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	seen := map[string]bool{}
+
+	for range 10 {
+		password, err := Generate(24)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(password) != 24 {
+			t.Errorf("len(%q) = %d, want 24", password, len(password))
+		}
+
+		if strings.Trim(password, passwordAlphabet) != "" {
+			t.Errorf("password %q contains characters outside of the alphabet", password)
+		}
+
+		if seen[password] {
+			t.Errorf("password %q generated twice", password)
+		}
+
+		seen[password] = true
+	}
+
+	if _, err := Generate(0); err == nil {
+		t.Error("Generate(0) succeeded, want error")
+	}
+}
+
+func TestNewSalt(t *testing.T) {
+	salt := NewSalt()
+
+	if err := validateSalt(salt); err != nil {
+		t.Errorf("invalid salt %q: %v", salt, err)
+	}
+
+	if len(salt) != MaxSaltLength {
+		t.Errorf("len(%q) = %d, want %d", salt, len(salt), MaxSaltLength)
+	}
+}
@@ -0,0 +1,81 @@
+// Package pagination iterates over the limit/offset paginated list endpoints
+// of the Uberspace API.
+package pagination
+
+import (
+	"context"
+	"iter"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+// PageSize is the number of objects requested per page.
+const PageSize = 100
+
+// Page is implemented by the generated Paginated*List types.
+type Page[T any] interface {
+	GetResults() []T
+	GetNext() client.OptNilURI
+}
+
+// FetchFunc requests a single page of a list.
+type FetchFunc[T any] func(ctx context.Context, limit, offset int) (Page[T], error)
+
+// All returns an iterator over the objects of all pages of a list. Pages are
+// requested lazily while iterating. If a request fails, the error is yielded
+// and the iteration ends.
+//
+//	for domain, err := range pagination.All(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebDomain], error) {
+//		return c.AsteroidsWebdomainsList(ctx, client.AsteroidsWebdomainsListParams{
+//			AsteroidName: "isabell",
+//			Limit:        client.NewOptInt(limit),
+//			Offset:       client.NewOptInt(offset),
+//		})
+//	}) {
+//		...
+//	}
+func All[T any](ctx context.Context, fetch FetchFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		offset := 0
+
+		for {
+			page, err := fetch(ctx, PageSize, offset)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			results := page.GetResults()
+
+			for _, item := range results {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if _, ok := page.GetNext().Get(); !ok || len(results) == 0 {
+				return
+			}
+
+			offset += len(results)
+		}
+	}
+}
+
+// Collect returns the objects of all pages of a list.
+func Collect[T any](ctx context.Context, fetch FetchFunc[T]) ([]T, error) {
+	var items []T
+
+	for item, err := range All(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,113 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+// testPages serves the given number of items in pages of PageSize.
+func testPages(t *testing.T, total int, requests *[]int) FetchFunc[int] {
+	t.Helper()
+
+	return func(_ context.Context, limit, offset int) (Page[int], error) {
+		*requests = append(*requests, offset)
+
+		page := &client.PaginatedWebHeaderList{Count: total}
+
+		for i := offset; i < min(offset+limit, total); i++ {
+			page.Results = append(page.Results, client.WebHeader{Pk: i})
+		}
+
+		if offset+limit < total {
+			page.Next = client.NewOptNilURI(url.URL{Scheme: "https", Host: "marvin.uberspace.is"})
+		}
+
+		return intPage{page}, nil
+	}
+}
+
+// intPage maps a header page to the header primary keys.
+type intPage struct {
+	*client.PaginatedWebHeaderList
+}
+
+func (p intPage) GetResults() []int {
+	pks := make([]int, 0, len(p.Results))
+	for _, h := range p.Results {
+		pks = append(pks, h.Pk)
+	}
+
+	return pks
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name         string
+		total        int
+		wantRequests []int
+	}{
+		{name: "empty", total: 0, wantRequests: []int{0}},
+		{name: "single page", total: 3, wantRequests: []int{0}},
+		{name: "exactly one page", total: PageSize, wantRequests: []int{0}},
+		{name: "multiple pages", total: 2*PageSize + 1, wantRequests: []int{0, PageSize, 2 * PageSize}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []int
+
+			items, err := Collect(t.Context(), testPages(t, tt.total, &requests))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(items) != tt.total {
+				t.Errorf("got %d items, want %d", len(items), tt.total)
+			}
+
+			for i, item := range items {
+				if item != i {
+					t.Fatalf("item %d = %d, want %d", i, item, i)
+				}
+			}
+
+			if !slices.Equal(requests, tt.wantRequests) {
+				t.Errorf("requested offsets %v, want %v", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestAllStopsEarly(t *testing.T) {
+	var requests []int
+
+	for item, err := range All(t.Context(), testPages(t, 3*PageSize, &requests)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if item == 5 {
+			break
+		}
+	}
+
+	if len(requests) != 1 {
+		t.Errorf("got %d requests, want 1", len(requests))
+	}
+}
+
+func TestAllError(t *testing.T) {
+	wantErr := errors.New("unexpected status code: 500")
+
+	_, err := Collect(t.Context(), func(context.Context, int, int) (Page[int], error) {
+		return nil, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("got error %v, want %v", err, wantErr)
+	}
+}
@@ -1,19 +1,111 @@
 package provider
 
-import "net/http"
+import (
+	"cmp"
+	"context"
+	"maps"
+	"net/http"
+	"path"
+	"slices"
+	"time"
+)
+
+// APIKeys holds the API keys of the provider. Asteroids maps asteroid names
+// or path.Match patterns like "team-*" to the key of the Uberspace account
+// owning them. Requests to other asteroids use the Default key.
+type APIKeys struct {
+	Default   string
+	Asteroids map[string]string
+}
+
+// ForAsteroid returns the API key for requests to the given asteroid. An
+// exact name match takes precedence over patterns, and longer patterns take
+// precedence over shorter ones.
+func (k APIKeys) ForAsteroid(asteroid string) string {
+	if asteroid == "" {
+		return k.Default
+	}
+
+	if apikey, ok := k.Asteroids[asteroid]; ok {
+		return apikey
+	}
+
+	patterns := slices.SortedFunc(maps.Keys(k.Asteroids), func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), cmp.Compare(a, b))
+	})
+
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, asteroid); ok {
+			return k.Asteroids[pattern]
+		}
+	}
+
+	return k.Default
+}
+
+// checkAsteroidPattern reports whether pattern is a valid asteroid pattern.
+func checkAsteroidPattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
 
 type AuthClient struct {
-	apikey string
+	apikeys    APIKeys
+	userAgent  string
+	httpClient *http.Client
 }
 
-func NewAuthClient(apikey string) *AuthClient {
+func NewAuthClient(apikeys APIKeys, userAgent string, httpClient *http.Client) *AuthClient {
 	return &AuthClient{
-		apikey: apikey,
+		apikeys:    apikeys,
+		userAgent:  userAgent,
+		httpClient: httpClient,
 	}
 }
 
+// UserAgent returns the User-Agent sent by the provider, e.g.
+// "terraform-provider-uberspace/0.2.0 terraform/1.14.0".
+func UserAgent(providerVersion, terraformVersion string) string {
+	userAgent := "terraform-provider-uberspace/" + providerVersion
+
+	if terraformVersion != "" {
+		userAgent += " terraform/" + terraformVersion
+	}
+
+	return userAgent
+}
+
+type asteroidContextKey struct{}
+
+// withAsteroid returns a context for requests on behalf of an asteroid, so
+// requests to endpoints without an asteroid in their path, like the events,
+// use the API key of that asteroid.
+func withAsteroid(ctx context.Context, asteroid string) context.Context {
+	return context.WithValue(ctx, asteroidContextKey{}, asteroid)
+}
+
+// requestAsteroid returns the asteroid a request is made for.
+func requestAsteroid(r *http.Request) string {
+	if asteroid := asteroidFromPath(r.URL.Path); asteroid != "" {
+		return asteroid
+	}
+
+	asteroid, _ := r.Context().Value(asteroidContextKey{}).(string)
+
+	return asteroid
+}
+
 func (a *AuthClient) Do(r *http.Request) (*http.Response, error) {
-	r.Header.Set("Authorization", "Api-Key "+a.apikey)
+	r.Header.Set("Authorization", "Api-Key "+a.apikeys.ForAsteroid(requestAsteroid(r)))
+	r.Header.Set("User-Agent", a.userAgent)
+
+	ctx := newAPILogContext(r.Context(), r)
+	logRequest(ctx, r)
+
+	start := time.Now()
+	resp, err := a.httpClient.Do(r)
+
+	logResponse(ctx, resp, err, time.Since(start))
 
-	return http.DefaultClient.Do(r) //nolint: gosec
+	return resp, err
 }
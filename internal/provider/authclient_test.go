@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		providerVersion  string
+		terraformVersion string
+		want             string
+	}{
+		{"0.2.0", "1.14.0", "terraform-provider-uberspace/0.2.0 terraform/1.14.0"},
+		{"dev", "", "terraform-provider-uberspace/dev"},
+	}
+
+	for _, tt := range tests {
+		if got := UserAgent(tt.providerVersion, tt.terraformVersion); got != tt.want {
+			t.Errorf("UserAgent(%q, %q) = %q, want %q", tt.providerVersion, tt.terraformVersion, got, tt.want)
+		}
+	}
+}
+
+func TestAuthClientDo(t *testing.T) {
+	var got http.Header
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewAuthClient(APIKeys{Default: "secret"}, "terraform-provider-uberspace/test", server.Client())
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if v := got.Get("Authorization"); v != "Api-Key secret" {
+		t.Errorf("Authorization = %q, want %q", v, "Api-Key secret")
+	}
+
+	if v := got.Get("User-Agent"); v != "terraform-provider-uberspace/test" {
+		t.Errorf("User-Agent = %q, want %q", v, "terraform-provider-uberspace/test")
+	}
+}
+
+func TestAPIKeysForAsteroid(t *testing.T) {
+	keys := APIKeys{
+		Default: "default",
+		Asteroids: map[string]string{
+			"isabell":     "isabell",
+			"team-*":      "team",
+			"team-ops-*":  "ops",
+			"team-ops-01": "ops-01",
+		},
+	}
+
+	tests := map[string]string{
+		"":            "default",
+		"isabell":     "isabell",
+		"terra":       "default",
+		"team-web":    "team",
+		"team-ops-02": "ops",
+		"team-ops-01": "ops-01",
+	}
+
+	for asteroid, want := range tests {
+		if got := keys.ForAsteroid(asteroid); got != want {
+			t.Errorf("ForAsteroid(%q) = %q, want %q", asteroid, got, want)
+		}
+	}
+}
+
+func TestAuthClientDoAsteroidAPIKey(t *testing.T) {
+	var got string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewAuthClient(APIKeys{Default: "default", Asteroids: map[string]string{"isabell": "isabell"}}, "terraform-provider-uberspace/test", server.Client())
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/api/v1/external/asteroids/isabell/webdomains/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if got != "Api-Key isabell" {
+		t.Errorf("Authorization = %q, want %q", got, "Api-Key isabell")
+	}
+}
+
+func TestAuthClientDoContextAsteroid(t *testing.T) {
+	var got string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewAuthClient(APIKeys{Default: "default", Asteroids: map[string]string{"isabell": "isabell"}}, "terraform-provider-uberspace/test", server.Client())
+
+	req, err := http.NewRequestWithContext(withAsteroid(t.Context(), "isabell"), http.MethodGet, server.URL+"/api/v1/common/events/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if got != "Api-Key isabell" {
+		t.Errorf("Authorization = %q, want %q", got, "Api-Key isabell")
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+
+	ht "github.com/ogen-go/ogen/http"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// ReadCache serves resource reads from the list endpoints, so a refresh
+// fetches all web backends and web headers of an asteroid and all mail users
+// of a mail domain with a few requests instead of one request per object.
+// The cache lives for a single provider run and an asteroid's entries are
+// dropped on every modifying request to it. If disabled, or if an object is
+// missing from the cached lists, reads go straight to the API.
+type ReadCache struct {
+	enabled bool
+
+	mu        sync.Mutex
+	backends  map[string]*cacheEntry[client.WebBackend]
+	headers   map[string]*cacheEntry[client.WebHeader]
+	mailusers map[mailuserCacheKey]*cacheEntry[client.MailUser]
+}
+
+type mailuserCacheKey struct {
+	asteroid   string
+	maildomain string
+}
+
+// cacheEntry holds a list that is fetched once by the first reader.
+type cacheEntry[T any] struct {
+	once  sync.Once
+	items []T
+	err   error
+}
+
+func NewReadCache(enabled bool) *ReadCache {
+	return &ReadCache{
+		enabled:   enabled,
+		backends:  map[string]*cacheEntry[client.WebBackend]{},
+		headers:   map[string]*cacheEntry[client.WebHeader]{},
+		mailusers: map[mailuserCacheKey]*cacheEntry[client.MailUser]{},
+	}
+}
+
+// Invalidate drops all cached lists of an asteroid.
+func (rc *ReadCache) Invalidate(asteroid string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	delete(rc.backends, asteroid)
+	delete(rc.headers, asteroid)
+
+	for key := range rc.mailusers {
+		if key.asteroid == asteroid {
+			delete(rc.mailusers, key)
+		}
+	}
+}
+
+// WebBackend returns a web backend of a web domain.
+func (rc *ReadCache) WebBackend(ctx context.Context, c *client.Client, params client.AsteroidsWebdomainsBackendsGetParams) (*client.WebBackend, error) {
+	if rc.enabled {
+		backends, err := load(rc, rc.backends, params.AsteroidName, func() ([]client.WebBackend, error) {
+			return pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebBackend], error) {
+				return c.AsteroidsWebbackendsList(ctx, client.AsteroidsWebbackendsListParams{
+					AsteroidName: params.AsteroidName,
+					Limit:        client.NewOptInt(limit),
+					Offset:       client.NewOptInt(offset),
+				})
+			})
+		})
+		if err == nil {
+			for i := range backends {
+				if backends[i].Domain.Or("") == params.WebdomainName && backends[i].Path == params.Path {
+					return &backends[i], nil
+				}
+			}
+		}
+	}
+
+	return c.AsteroidsWebdomainsBackendsGet(ctx, params)
+}
+
+// WebHeader returns a web header of a web domain.
+func (rc *ReadCache) WebHeader(ctx context.Context, c *client.Client, params client.AsteroidsWebdomainsHeadersGetParams) (*client.WebHeader, error) {
+	if rc.enabled {
+		headers, err := load(rc, rc.headers, params.AsteroidName, func() ([]client.WebHeader, error) {
+			return pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebHeader], error) {
+				return c.AsteroidsWebheadersList(ctx, client.AsteroidsWebheadersListParams{
+					AsteroidName: params.AsteroidName,
+					Limit:        client.NewOptInt(limit),
+					Offset:       client.NewOptInt(offset),
+				})
+			})
+		})
+		if err == nil {
+			for i := range headers {
+				if headers[i].Domain.Or("") == params.WebdomainName && strconv.Itoa(headers[i].Pk) == params.ID {
+					return &headers[i], nil
+				}
+			}
+		}
+	}
+
+	return c.AsteroidsWebdomainsHeadersGet(ctx, params)
+}
+
+// MailUser returns a mail user of a mail domain.
+func (rc *ReadCache) MailUser(ctx context.Context, c *client.Client, params client.AsteroidsMaildomainsUsersGetParams) (*client.MailUser, error) {
+	if rc.enabled {
+		key := mailuserCacheKey{asteroid: params.AsteroidName, maildomain: params.MaildomainName}
+
+		users, err := load(rc, rc.mailusers, key, func() ([]client.MailUser, error) {
+			return pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.MailUser], error) {
+				return c.AsteroidsMaildomainsUsersList(ctx, client.AsteroidsMaildomainsUsersListParams{
+					AsteroidName:   params.AsteroidName,
+					MaildomainName: params.MaildomainName,
+					Limit:          client.NewOptInt(limit),
+					Offset:         client.NewOptInt(offset),
+				})
+			})
+		})
+		if err == nil {
+			for i := range users {
+				if users[i].Name == params.Local {
+					return &users[i], nil
+				}
+			}
+		}
+	}
+
+	return c.AsteroidsMaildomainsUsersGet(ctx, params)
+}
+
+// load returns the cached list for key, fetching it if necessary. Failed
+// fetches are not cached, so the next reader tries again.
+func load[K comparable, T any](rc *ReadCache, entries map[K]*cacheEntry[T], key K, fetch func() ([]T, error)) ([]T, error) {
+	rc.mu.Lock()
+
+	entry, ok := entries[key]
+	if !ok {
+		entry = &cacheEntry[T]{}
+		entries[key] = entry
+	}
+
+	rc.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.items, entry.err = fetch()
+	})
+
+	if entry.err != nil {
+		rc.mu.Lock()
+		if entries[key] == entry {
+			delete(entries, key)
+		}
+		rc.mu.Unlock()
+	}
+
+	return entry.items, entry.err
+}
+
+// cacheInvalidator drops the cached lists of an asteroid after every
+// modifying request to it.
+type cacheInvalidator struct {
+	next  ht.Client
+	cache *ReadCache
+}
+
+func (i cacheInvalidator) Do(r *http.Request) (*http.Response, error) {
+	resp, err := i.next.Do(r)
+
+	if isModifying(r.Method) {
+		if asteroid := asteroidFromPath(r.URL.Path); asteroid != "" {
+			i.cache.Invalidate(asteroid)
+		}
+	}
+
+	return resp, err
+}
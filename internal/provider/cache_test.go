@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+const testBackendJSON = `{"pk":%d,"asteroid":"isabell","domain":"isabell.uber.space","path":%q,"remove_prefix":false,"destination":"STATIC","port":null,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`
+
+func TestReadCacheWebBackend(t *testing.T) {
+	var lists, gets, deletes atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/external/asteroids/isabell/webbackends/", func(w http.ResponseWriter, r *http.Request) {
+		lists.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprintf(w, `{"count":2,"next":"https://marvin.uberspace.is/?offset=1","previous":null,"results":[`+testBackendJSON+`]}`, 1, "/a")
+			return
+		}
+
+		fmt.Fprintf(w, `{"count":2,"next":null,"previous":"https://marvin.uberspace.is/?offset=0","results":[`+testBackendJSON+`]}`, 2, "/b")
+	})
+	mux.HandleFunc("GET /api/v1/external/asteroids/isabell/webdomains/isabell.uber.space/backends/{path}/", func(w http.ResponseWriter, r *http.Request) {
+		gets.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, testBackendJSON, 3, "/"+strings.TrimPrefix(r.PathValue("path"), "/"))
+	})
+	mux.HandleFunc("DELETE /api/v1/external/asteroids/isabell/webdomains/isabell.uber.space/backends/{path}/", func(w http.ResponseWriter, _ *http.Request) {
+		deletes.Add(1)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cache := NewReadCache(true)
+
+	c, err := client.NewClient(server.URL, client.WithClient(cacheInvalidator{next: server.Client(), cache: cache}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(path string) *client.WebBackend {
+		t.Helper()
+
+		backend, err := cache.WebBackend(t.Context(), c, client.AsteroidsWebdomainsBackendsGetParams{
+			AsteroidName:  "isabell",
+			WebdomainName: "isabell.uber.space",
+			Path:          path,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return backend
+	}
+
+	if backend := read("/a"); backend.Pk != 1 {
+		t.Errorf("Pk = %d, want 1", backend.Pk)
+	}
+
+	if backend := read("/b"); backend.Pk != 2 {
+		t.Errorf("Pk = %d, want 2", backend.Pk)
+	}
+
+	if n := lists.Load(); n != 2 {
+		t.Errorf("list requests = %d, want 2 (one per page)", n)
+	}
+
+	// objects missing from the list are read directly
+	if backend := read("/c"); backend.Pk != 3 {
+		t.Errorf("Pk = %d, want 3", backend.Pk)
+	}
+
+	if n := gets.Load(); n != 1 {
+		t.Errorf("get requests = %d, want 1", n)
+	}
+
+	// writes invalidate the cache
+	if err := c.AsteroidsWebdomainsBackendsDelete(t.Context(), client.AsteroidsWebdomainsBackendsDeleteParams{
+		AsteroidName:  "isabell",
+		WebdomainName: "isabell.uber.space",
+		Path:          "/a",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	read("/a")
+
+	if n := lists.Load(); n != 4 {
+		t.Errorf("list requests = %d, want 4 after invalidation", n)
+	}
+}
+
+func TestReadCacheDisabled(t *testing.T) {
+	var lists, gets atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/external/asteroids/isabell/webbackends/", func(http.ResponseWriter, *http.Request) {
+		lists.Add(1)
+	})
+	mux.HandleFunc("GET /api/v1/external/asteroids/isabell/webdomains/isabell.uber.space/backends/{path}/", func(w http.ResponseWriter, r *http.Request) {
+		gets.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, testBackendJSON, 1, "/"+strings.TrimPrefix(r.PathValue("path"), "/"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, client.WithClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := NewReadCache(false)
+
+	for range 2 {
+		if _, err := cache.WebBackend(t.Context(), c, client.AsteroidsWebdomainsBackendsGetParams{
+			AsteroidName:  "isabell",
+			WebdomainName: "isabell.uber.space",
+			Path:          "/a",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if lists.Load() != 0 || gets.Load() != 2 {
+		t.Errorf("lists = %d, gets = %d, want 0 and 2", lists.Load(), gets.Load())
+	}
+}
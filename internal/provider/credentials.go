@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+// readAPIKeyFile reads an API key from a file, ignoring surrounding whitespace.
+func readAPIKeyFile(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API key file: %w", err)
+	}
+
+	apikey := strings.TrimSpace(string(content))
+	if apikey == "" {
+		return "", fmt.Errorf("API key file %s is empty", name)
+	}
+
+	return apikey, nil
+}
+
+// runAPIKeyCommand runs a credential helper like "pass show uberspace/isabell"
+// through the shell and returns the first line of its output as API key.
+func runAPIKeyCommand(ctx context.Context, command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("API key command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	apikey, _, _ := strings.Cut(strings.TrimSpace(string(output)), "\n")
+	apikey = strings.TrimSpace(apikey)
+
+	if apikey == "" {
+		return "", fmt.Errorf("API key command returned no output")
+	}
+
+	return apikey, nil
+}
+
+// validateCredentials performs a lightweight authenticated request for every
+// configured API key, so invalid keys are reported during provider
+// configuration instead of on the first resource operation. Keys configured
+// for asteroid patterns cannot be checked up front. Errors about the default
+// key are reported at defaultKeyPath, the attribute the key was read from, or
+// without attribute if it was read from the environment.
+func validateCredentials(ctx context.Context, c *client.Client, apikeys APIKeys, defaultKeyPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apikeys.Default != "" {
+		detail := "The API key was rejected by the Uberspace API."
+		if defaultKeyPath.Equal(path.Empty()) {
+			detail = "The API key from the UBERSPACE_APIKEY environment variable was rejected by the Uberspace API."
+		}
+
+		_, err := c.ToolsList(ctx, client.ToolsListParams{Limit: client.NewOptInt(1)})
+		addCredentialsError(&diags, defaultKeyPath, detail, err)
+	}
+
+	for _, asteroid := range slices.Sorted(maps.Keys(apikeys.Asteroids)) {
+		if strings.ContainsAny(asteroid, `*?[\`) {
+			continue
+		}
+
+		_, err := c.AsteroidsGet(ctx, client.AsteroidsGetParams{Name: asteroid})
+		addCredentialsError(&diags, path.Root("asteroid_apikeys").AtMapKey(asteroid), fmt.Sprintf("The API key for asteroid %s was rejected by the Uberspace API or has no access to it.", asteroid), err)
+	}
+
+	return diags
+}
+
+func addCredentialsError(diags *diag.Diagnostics, attributePath path.Path, detail string, err error) {
+	if err == nil {
+		return
+	}
+
+	switch statusCode(err) {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		detail += " Please check that the configured API key is complete and has not been revoked."
+
+		if attributePath.Equal(path.Empty()) {
+			diags.AddError("Invalid API key", detail)
+		} else {
+			diags.AddAttributeError(attributePath, "Invalid API key", detail)
+		}
+	default:
+		diags.AddError(
+			"Unable to validate credentials",
+			"An error occurred while validating the API key: "+err.Error()+". Set skip_credentials_validation to skip this check.",
+		)
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+func TestReadAPIKeyFile(t *testing.T) {
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(dir, "apikey")
+	if err := os.WriteFile(keyFile, []byte("  secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	emptyFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyFile, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := readAPIKeyFile(keyFile); err != nil || got != "secret" {
+		t.Errorf("readAPIKeyFile() = %q, %v, want %q", got, err, "secret")
+	}
+
+	if _, err := readAPIKeyFile(emptyFile); err == nil {
+		t.Error("expected error for empty file")
+	}
+
+	if _, err := readAPIKeyFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestRunAPIKeyCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+
+	tests := []struct {
+		name    string
+		command string
+		want    string
+		wantErr bool
+	}{
+		{name: "first line", command: "printf 'secret\\nurl: https://uberspace.de\\n'", want: "secret"},
+		{name: "no output", command: "true", wantErr: true},
+		{name: "failure", command: "echo denied >&2; exit 1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runAPIKeyCommand(t.Context(), tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runAPIKeyCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("runAPIKeyCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCredentials(t *testing.T) {
+	var asteroids []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/external/tools/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Api-Key valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":0,"next":null,"previous":null,"results":[]}`)
+	})
+	mux.HandleFunc("GET /api/v1/external/asteroids/{name}/", func(w http.ResponseWriter, r *http.Request) {
+		asteroids = append(asteroids, r.PathValue("name"))
+
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		apikeys   APIKeys
+		keyPath   path.Path
+		wantPaths []string
+	}{
+		{name: "valid", apikeys: APIKeys{Default: "valid"}, keyPath: path.Root("apikey")},
+		{name: "invalid", apikeys: APIKeys{Default: "invalid"}, keyPath: path.Root("apikey"), wantPaths: []string{`apikey`}},
+		{name: "invalid file", apikeys: APIKeys{Default: "invalid"}, keyPath: path.Root("apikey_file"), wantPaths: []string{`apikey_file`}},
+		{name: "invalid environment", apikeys: APIKeys{Default: "invalid"}, keyPath: path.Empty(), wantPaths: []string{``}},
+		{
+			name:      "asteroid",
+			apikeys:   APIKeys{Default: "valid", Asteroids: map[string]string{"isabell": "invalid", "team-*": "invalid"}},
+			keyPath:   path.Root("apikey"),
+			wantPaths: []string{`asteroid_apikeys["isabell"]`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := client.NewClient(server.URL, client.WithClient(NewAuthClient(tt.apikeys, "terraform-provider-uberspace/test", server.Client())))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := validateCredentials(t.Context(), c, tt.apikeys, tt.keyPath)
+
+			var paths []string
+
+			for _, d := range diags {
+				if d.Summary() != "Invalid API key" {
+					t.Errorf("unexpected diagnostic %q: %s", d.Summary(), d.Detail())
+				}
+
+				// diagnostics without attribute are recorded with an empty path
+				var p path.Path
+				if d, ok := d.(diag.DiagnosticWithPath); ok {
+					p = d.Path()
+				}
+
+				paths = append(paths, p.String())
+			}
+
+			if !slices.Equal(paths, tt.wantPaths) {
+				t.Errorf("diagnostic paths = %v, want %v", paths, tt.wantPaths)
+			}
+		})
+	}
+
+	if !slices.Equal(asteroids, []string{"isabell"}) {
+		t.Errorf("validated asteroids %v, want [isabell]", asteroids)
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// isConflict reports whether err is an API response signalling that the
+// object to be created already exists. The API answers with 409 Conflict or
+// with a 400 validation error containing Django's uniqueness message.
+func isConflict(err error) bool {
+	var statusErr *validate.UnexpectedStatusCodeError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusBadRequest:
+		return strings.Contains(string(responseBody(statusErr)), "already exists")
+	default:
+		return false
+	}
+}
+
+// statusCode returns the HTTP status code of an unexpected status code error
+// or 0 for other errors.
+func statusCode(err error) int {
+	var statusErr *validate.UnexpectedStatusCodeError
+	if !errors.As(err, &statusErr) {
+		return 0
+	}
+
+	return statusErr.StatusCode
+}
+
+// responseBody returns the retained body of an unexpected status code error.
+// The body is restored so it can be read again by other helpers.
+func responseBody(err *validate.UnexpectedStatusCodeError) []byte {
+	if err.Payload == nil || err.Payload.Body == nil {
+		return nil
+	}
+
+	body, _ := io.ReadAll(err.Payload.Body)
+	err.Payload.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body
+}
+
+// addClientError adds diagnostics for an error returned by the API client.
+// Field validation errors are attached to the attribute of the same name,
+// all other errors are reported with the given summary as detail prefix,
+// e.g. "Unable to create web domain".
+func addClientError(diags *diag.Diagnostics, summary string, err error) {
+	fieldErrors, generalErrors, ok := decodeAPIError(err)
+	if !ok {
+		diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", summary, err))
+		return
+	}
+
+	for _, field := range slices.Sorted(maps.Keys(fieldErrors)) {
+		diags.AddAttributeError(
+			path.Root(field),
+			"Client Error",
+			fmt.Sprintf("%s, invalid value for %s: %s", summary, field, strings.Join(fieldErrors[field], " ")),
+		)
+	}
+
+	if len(generalErrors) > 0 {
+		diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", summary, strings.Join(generalErrors, " ")))
+	}
+}
+
+// decodeAPIError decodes the DRF style error body of an unexpected status
+// code error. Messages keyed by field name are returned as field errors,
+// "detail" and "non_field_errors" as general errors.
+func decodeAPIError(err error) (fieldErrors map[string][]string, generalErrors []string, ok bool) {
+	var statusErr *validate.UnexpectedStatusCodeError
+	if !errors.As(err, &statusErr) {
+		return nil, nil, false
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(responseBody(statusErr), &body); err != nil || len(body) == 0 {
+		return nil, nil, false
+	}
+
+	fieldErrors = map[string][]string{}
+
+	for key, raw := range body {
+		messages := errorMessages(raw)
+
+		switch key {
+		case "detail", "non_field_errors":
+			generalErrors = append(generalErrors, messages...)
+		default:
+			fieldErrors[key] = messages
+		}
+	}
+
+	return fieldErrors, generalErrors, true
+}
+
+// errorMessages converts a single error entry, which is either a string or a
+// list of strings, into a list of messages. Nested errors are kept as JSON.
+func errorMessages(raw json.RawMessage) []string {
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return []string{message}
+	}
+
+	var messages []string
+	if err := json.Unmarshal(raw, &messages); err == nil {
+		return messages
+	}
+
+	return []string{string(raw)}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/ogen-go/ogen/validate"
+)
+
+func testStatusError(t *testing.T, code int, body string) error {
+	t.Helper()
+
+	return validate.UnexpectedStatusCodeWithResponse(&http.Response{
+		StatusCode: code,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	})
+}
+
+func TestIsConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "conflict",
+			err:  testStatusError(t, http.StatusConflict, ""),
+			want: true,
+		},
+		{
+			name: "unique validation error",
+			err:  testStatusError(t, http.StatusBadRequest, `{"name":["web domain with this name already exists."]}`),
+			want: true,
+		},
+		{
+			name: "other validation error",
+			err:  testStatusError(t, http.StatusBadRequest, `{"port":["Ensure this value is greater than or equal to 1024."]}`),
+			want: false,
+		},
+		{
+			name: "not found",
+			err:  testStatusError(t, http.StatusNotFound, ""),
+			want: false,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isConflict(tt.err); got != tt.want {
+				t.Errorf("isConflict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want diag.Diagnostics
+	}{
+		{
+			name: "field errors",
+			err:  testStatusError(t, http.StatusBadRequest, `{"port":["Ensure this value is greater than or equal to 1024."],"name":["This field is required."]}`),
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("name"), "Client Error", "Unable to create web domain backend, invalid value for name: This field is required."),
+				diag.NewAttributeErrorDiagnostic(path.Root("port"), "Client Error", "Unable to create web domain backend, invalid value for port: Ensure this value is greater than or equal to 1024."),
+			},
+		},
+		{
+			name: "detail",
+			err:  testStatusError(t, http.StatusNotFound, `{"detail":"Not found."}`),
+			want: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", "Unable to create web domain backend, got error: Not found."),
+			},
+		},
+		{
+			name: "non field errors",
+			err:  testStatusError(t, http.StatusBadRequest, `{"non_field_errors":["Path is already in use.","Try again."]}`),
+			want: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", "Unable to create web domain backend, got error: Path is already in use. Try again."),
+			},
+		},
+		{
+			name: "non json body",
+			err:  testStatusError(t, http.StatusBadGateway, "<html>Bad Gateway</html>"),
+			want: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", "Unable to create web domain backend, got error: unexpected status code: 502"),
+			},
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", "Unable to create web domain backend, got error: connection refused"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			addClientError(&diags, "Unable to create web domain backend", tt.err)
+
+			if !diags.Equal(tt.want) {
+				t.Errorf("addClientError() = %v, want %v", diags, tt.want)
+			}
+		})
+	}
+}
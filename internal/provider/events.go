@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+const (
+	// eventPollInterval is the time between two checks of pending events.
+	eventPollInterval = 2 * time.Second
+
+	// eventGracePeriod is how long to wait for an event to show up at all.
+	// Not every change is processed asynchronously, so a missing event is
+	// no error.
+	eventGracePeriod = 10 * time.Second
+)
+
+// EventWaiter blocks after modifying requests until the events Marvin
+// created for the changed object are processed. If disabled, all methods
+// return immediately.
+type EventWaiter struct {
+	enabled      bool
+	pollInterval time.Duration
+	gracePeriod  time.Duration
+}
+
+func NewEventWaiter(enabled bool) *EventWaiter {
+	return &EventWaiter{
+		enabled:      enabled,
+		pollInterval: eventPollInterval,
+		gracePeriod:  eventGracePeriod,
+	}
+}
+
+// EventMarker identifies the newest event before a change.
+type EventMarker struct {
+	pk int
+}
+
+// Content types of the objects events are waited for. Marvin reports the
+// model name, which is compared case-insensitively and may carry an app
+// label, e.g. "web.webdomain".
+const (
+	contentTypeWebDomain  = "webdomain"
+	contentTypeWebBackend = "webbackend"
+	contentTypeWebHeader  = "webheader"
+	contentTypeMailDomain = "maildomain"
+	contentTypeMailUser   = "mailuser"
+	contentTypeSSHKey     = "sshkey"
+)
+
+// EventObject describes the object events are waited for. Events match if
+// their content type equals ContentType and their object id equals ID or
+// their object name or human identifier is one of Names.
+type EventObject struct {
+	ContentType string
+	ID          int
+	Names       []string
+}
+
+func (o EventObject) matches(event client.Event) bool {
+	contentType := strings.ToLower(event.ContentType)
+	if contentType != o.ContentType && !strings.HasSuffix(contentType, "."+o.ContentType) {
+		return false
+	}
+
+	if id, ok := event.ObjectID.Get(); ok && o.ID != 0 && id == o.ID {
+		return true
+	}
+
+	if name, ok := event.ObjectName.Get(); ok && slices.Contains(o.Names, name) {
+		return true
+	}
+
+	return slices.Contains(o.Names, event.HumanIdentifier)
+}
+
+// EventFailedError is returned if an event ended in state FAILED.
+type EventFailedError struct {
+	Event client.Event
+	Logs  []client.JobLog
+}
+
+func (e *EventFailedError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "event %d (%s) failed", e.Event.Pk, e.Event.HumanIdentifier)
+
+	for _, log := range e.Logs {
+		fmt.Fprintf(&b, "\n%s [%s] %s", log.CreatedAt.Format(time.RFC3339), log.StateNew, log.Message)
+	}
+
+	return b.String()
+}
+
+// Mark remembers the newest event, call it before the modifying request.
+// Events are read with the API key of the asteroid.
+func (w *EventWaiter) Mark(ctx context.Context, c *client.Client, asteroid string) (EventMarker, error) {
+	if !w.enabled {
+		return EventMarker{}, nil
+	}
+
+	ctx = withAsteroid(ctx, asteroid)
+
+	events, err := c.EventsList(ctx, client.EventsListParams{Limit: client.NewOptInt(1)})
+	if err != nil {
+		return EventMarker{}, err
+	}
+
+	if len(events.Results) == 0 {
+		return EventMarker{}, nil
+	}
+
+	return EventMarker{pk: events.Results[0].Pk}, nil
+}
+
+// Wait blocks until all events for the object created after the marker are
+// DONE or SKIPPED. It returns an *EventFailedError with the event logs if one
+// of them FAILED. Events are read with the API key of the asteroid.
+func (w *EventWaiter) Wait(ctx context.Context, c *client.Client, asteroid string, marker EventMarker, object EventObject) error {
+	if !w.enabled {
+		return nil
+	}
+
+	ctx = withAsteroid(ctx, asteroid)
+
+	deadline := time.Now().Add(w.gracePeriod)
+
+	for {
+		events, err := newEvents(ctx, c, marker, object)
+		if err != nil {
+			return err
+		}
+
+		pending := false
+
+		for _, event := range events {
+			switch event.State {
+			case client.EventStateEnumFAILED:
+				return eventFailed(ctx, c, event)
+			case client.EventStateEnumPENDING, client.EventStateEnumRUNNING:
+				pending = true
+			}
+		}
+
+		if len(events) > 0 && !pending {
+			return nil
+		}
+
+		if len(events) == 0 && time.Now().After(deadline) {
+			tflog.Debug(ctx, "no event found for object", map[string]any{"content_type": object.ContentType, "names": object.Names, "id": object.ID})
+
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for events: %w", ctx.Err())
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// newEvents returns the events for the object newer than the marker. Marvin
+// lists the newest events first, but the API cannot request an order, so
+// every event is compared with the marker and paging only stops at a page
+// without newer events.
+func newEvents(ctx context.Context, c *client.Client, marker EventMarker, object EventObject) ([]client.Event, error) {
+	var events []client.Event
+
+	for offset := 0; ; {
+		page, err := c.EventsList(ctx, client.EventsListParams{
+			Limit:  client.NewOptInt(pagination.PageSize),
+			Offset: client.NewOptInt(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		newer := false
+
+		for _, event := range page.Results {
+			if event.Pk <= marker.pk {
+				continue
+			}
+
+			newer = true
+
+			if object.matches(event) {
+				events = append(events, event)
+			}
+		}
+
+		if _, ok := page.Next.Get(); !ok || !newer || len(page.Results) == 0 {
+			return events, nil
+		}
+
+		offset += len(page.Results)
+	}
+}
+
+func eventFailed(ctx context.Context, c *client.Client, event client.Event) error {
+	logs, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.JobLog], error) {
+		return c.EventsLogsList(ctx, client.EventsLogsListParams{
+			EventPk: event.Pk,
+			Limit:   client.NewOptInt(limit),
+			Offset:  client.NewOptInt(offset),
+		})
+	})
+	if err != nil {
+		tflog.Warn(ctx, "unable to read event logs", map[string]any{"event": event.Pk, "error": err.Error()})
+	}
+
+	return &EventFailedError{Event: event, Logs: logs}
+}
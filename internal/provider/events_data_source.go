@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+const (
+	// DefaultEventsLimit is the number of events returned by the events data
+	// source without a limit.
+	DefaultEventsLimit = 20
+
+	// DefaultEventsMaxAge is how far back the events data source pages
+	// through the event history without a max_age. Marvin cannot filter
+	// events, so filters are applied while paging, newest first.
+	DefaultEventsMaxAge = 7 * 24 * time.Hour
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EventsDataSource{}
+
+func NewEventsDataSource() datasource.DataSource {
+	return &EventsDataSource{}
+}
+
+// EventsDataSource defines the data source implementation.
+type EventsDataSource struct {
+	client *client.Client
+}
+
+type eventsDataSourceModel struct {
+	APIKeyAsteroid types.String `tfsdk:"apikey_asteroid"`
+	ObjectName     types.String `tfsdk:"object_name"`
+	State          types.String `tfsdk:"state"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	MaxAge         types.String `tfsdk:"max_age"`
+	IncludeLogs    types.Bool   `tfsdk:"include_logs"`
+	Events         []eventModel `tfsdk:"events"`
+}
+
+type eventModel struct {
+	Pk              types.Int64   `tfsdk:"pk"`
+	CreatedAt       types.String  `tfsdk:"created_at"`
+	ContentType     types.String  `tfsdk:"content_type"`
+	ObjectID        types.Int64   `tfsdk:"object_id"`
+	ObjectName      types.String  `tfsdk:"object_name"`
+	HumanIdentifier types.String  `tfsdk:"human_identifier"`
+	Cause           types.String  `tfsdk:"cause"`
+	State           types.String  `tfsdk:"state"`
+	Logs            []jobLogModel `tfsdk:"logs"`
+}
+
+type jobLogModel struct {
+	CreatedAt  types.String `tfsdk:"created_at"`
+	StateOld   types.String `tfsdk:"state_old"`
+	StateNew   types.String `tfsdk:"state_new"`
+	Message    types.String `tfsdk:"message"`
+	ClientName types.String `tfsdk:"client_name"`
+}
+
+func (d *EventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+func (d *EventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	states := []string{
+		string(client.EventStateEnumPENDING),
+		string(client.EventStateEnumRUNNING),
+		string(client.EventStateEnumFAILED),
+		string(client.EventStateEnumSKIPPED),
+		string(client.EventStateEnumDONE),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Recent events of Marvin, the component processing changes on Uberspace, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"apikey_asteroid": schema.StringAttribute{
+				Description: "Read the events with the API key of this asteroid from `asteroid_apikeys` instead of the default API key. Events are not filtered by asteroid, they include the events of all asteroids of the key's Uberspace account.",
+				Optional:    true,
+			},
+			"object_name": schema.StringAttribute{
+				Description: "Only return events for the object with this name, e.g. a web domain.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return events in this state, one of `PENDING`, `RUNNING`, `FAILED`, `SKIPPED` or `DONE`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(states...),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of events to return. Defaults to `%d`.", DefaultEventsLimit),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_age": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return events younger than this duration, e.g. `24h`. Older events are not read at all, which bounds the number of requests for rarely matching filters. Defaults to `%dh`.", int(DefaultEventsMaxAge.Hours())),
+				Optional:    true,
+			},
+			"include_logs": schema.BoolAttribute{
+				Description: "Read the logs of every returned event.",
+				Optional:    true,
+			},
+			"events": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pk":               schema.Int64Attribute{Computed: true},
+						"created_at":       schema.StringAttribute{Computed: true},
+						"content_type":     schema.StringAttribute{Computed: true},
+						"object_id":        schema.Int64Attribute{Computed: true},
+						"object_name":      schema.StringAttribute{Computed: true},
+						"human_identifier": schema.StringAttribute{Computed: true},
+						"cause":            schema.StringAttribute{Computed: true},
+						"state":            schema.StringAttribute{Computed: true},
+						"logs": schema.ListNestedAttribute{
+							Description: "Logs of the event, only read if `include_logs` is set.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"created_at":  schema.StringAttribute{Computed: true},
+									"state_old":   schema.StringAttribute{Computed: true},
+									"state_new":   schema.StringAttribute{Computed: true},
+									"message":     schema.StringAttribute{Computed: true},
+									"client_name": schema.StringAttribute{Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *EventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data eventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxEvents := DefaultEventsLimit
+	if !data.Limit.IsNull() {
+		maxEvents = int(data.Limit.ValueInt64())
+	}
+
+	maxAge := DefaultEventsMaxAge
+	if !data.MaxAge.IsNull() {
+		var err error
+
+		maxAge, err = time.ParseDuration(data.MaxAge.ValueString())
+		if err != nil || maxAge <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_age"),
+				"Invalid configuration",
+				fmt.Sprintf("max_age must be a positive duration like 24h, got %q.", data.MaxAge.ValueString()),
+			)
+
+			return
+		}
+	}
+
+	oldest := time.Now().Add(-maxAge)
+
+	ctx = withAsteroid(ctx, data.APIKeyAsteroid.ValueString())
+
+	data.Events = []eventModel{}
+
+	for event, err := range pagination.All(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.Event], error) {
+		return d.client.EventsList(ctx, client.EventsListParams{
+			Limit:  client.NewOptInt(limit),
+			Offset: client.NewOptInt(offset),
+		})
+	}) {
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to read events", err)
+			return
+		}
+
+		// events are listed newest first, all further events are older
+		if event.CreatedAt.Before(oldest) {
+			break
+		}
+
+		if !data.ObjectName.IsNull() && event.ObjectName.Or("") != data.ObjectName.ValueString() {
+			continue
+		}
+
+		if !data.State.IsNull() && string(event.State) != data.State.ValueString() {
+			continue
+		}
+
+		model := eventModel{
+			Pk:              types.Int64Value(int64(event.Pk)),
+			CreatedAt:       types.StringValue(event.CreatedAt.Format(time.RFC3339)),
+			ContentType:     types.StringValue(event.ContentType),
+			ObjectID:        types.Int64Null(),
+			ObjectName:      types.StringNull(),
+			HumanIdentifier: types.StringValue(event.HumanIdentifier),
+			Cause:           types.StringValue(string(event.Cause)),
+			State:           types.StringValue(string(event.State)),
+			Logs:            []jobLogModel{},
+		}
+
+		if id, ok := event.ObjectID.Get(); ok {
+			model.ObjectID = types.Int64Value(int64(id))
+		}
+
+		if name, ok := event.ObjectName.Get(); ok {
+			model.ObjectName = types.StringValue(name)
+		}
+
+		if data.IncludeLogs.ValueBool() {
+			logs, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.JobLog], error) {
+				return d.client.EventsLogsList(ctx, client.EventsLogsListParams{
+					EventPk: event.Pk,
+					Limit:   client.NewOptInt(limit),
+					Offset:  client.NewOptInt(offset),
+				})
+			})
+			if err != nil {
+				addClientError(&resp.Diagnostics, "Unable to read event logs", err)
+				return
+			}
+
+			for _, log := range logs {
+				model.Logs = append(model.Logs, jobLogModel{
+					CreatedAt:  types.StringValue(log.CreatedAt.Format(time.RFC3339)),
+					StateOld:   types.StringValue(string(log.StateOld)),
+					StateNew:   types.StringValue(string(log.StateNew)),
+					Message:    types.StringValue(log.Message),
+					ClientName: types.StringValue(log.ClientName),
+				})
+			}
+		}
+
+		data.Events = append(data.Events, model)
+
+		if len(data.Events) >= maxEvents {
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+func TestAccEventsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig("terra", 5),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uberspace_events.test",
+						tfjsonpath.New("limit"),
+						knownvalue.Int64Exact(5),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uberspace_events.test",
+						tfjsonpath.New("events"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccEventsDataSourceConfig(asteroid string, limit int) string {
+	return fmt.Sprintf(`
+data "uberspace_events" "test" {
+  apikey_asteroid = %[1]q
+  limit           = %[2]d
+  include_logs    = true
+}
+`, asteroid, limit)
+}
+
+func TestEventsDataSourceMaxAge(t *testing.T) {
+	ctx := t.Context()
+
+	var pages []string
+
+	// every page holds one event, each a day older than the previous one
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/common/events/", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		pages = append(pages, r.URL.Query().Get("offset"))
+
+		event := fmt.Sprintf(`{"pk":%d,"created_at":%q,"content_type":"webdomain","object_id":null,"object_name":"other.example","human_identifier":"other.example","cause":"CREATED","state":"DONE"}`,
+			100-offset, time.Now().Add(-time.Duration(offset)*24*time.Hour-time.Hour).Format(time.RFC3339))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count":100,"next":"https://marvin.uberspace.is/more","previous":null,"results":[%s]}`, event)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, client.WithClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := &EventsDataSource{client: c}
+
+	var schemaResp datasource.SchemaResponse
+
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(typ, nil)
+	}
+
+	config["object_name"] = tftypes.NewValue(tftypes.String, "isabell.example")
+	config["max_age"] = tftypes.NewValue(tftypes.String, "72h")
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+	}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	// events of the last three days and the first older one are read
+	if len(pages) != 4 {
+		t.Errorf("read %d pages, want 4", len(pages))
+	}
+}
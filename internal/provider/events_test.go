@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+const testEventJSON = `{"pk":%d,"created_at":"2025-01-01T00:00:00Z","content_type":"webdomain","object_id":%d,"object_name":%q,"human_identifier":%q,"cause":"CREATED","state":%q}`
+
+// testEvents serves a list of events, newest first. Every read of the list
+// advances the state of pending events by calling next.
+type testEvents struct {
+	mu     sync.Mutex
+	events []string
+	next   func(reads int) []string
+	reads  int
+}
+
+func (e *testEvents) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if r.URL.Query().Get("limit") != "1" {
+		e.reads++
+
+		if e.next != nil {
+			e.events = e.next(e.reads)
+		}
+	}
+
+	events := e.events
+	if r.URL.Query().Get("limit") == "1" && len(events) > 0 {
+		events = events[:1]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"count":%d,"next":null,"previous":null,"results":[%s]}`, len(events), strings.Join(events, ","))
+}
+
+func testEventWaiter(t *testing.T, events *testEvents) (*EventWaiter, *client.Client) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v1/common/events/", events)
+	mux.HandleFunc("GET /api/v1/common/events/{pk}/logs/", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":1,"next":null,"previous":null,"results":[{"created_at":"2025-01-01T00:00:00Z","job":1,"state_old":"RUNNING","state_new":"FAILED","message":"certificate request failed","client_name":"tuttle"}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, client.WithClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waiter := NewEventWaiter(true)
+	waiter.pollInterval = time.Millisecond
+	waiter.gracePeriod = 20 * time.Millisecond
+
+	return waiter, c
+}
+
+func TestEventWaiterWait(t *testing.T) {
+	old := fmt.Sprintf(testEventJSON, 1, 1, "isabell.example", "isabell.example", "FAILED")
+	other := fmt.Sprintf(testEventJSON, 2, 2, "other.example", "other.example", "RUNNING")
+
+	tests := []struct {
+		name      string
+		next      func(reads int) []string
+		wantErr   string
+		wantReads int
+	}{
+		{
+			name: "done",
+			next: func(reads int) []string {
+				state := "RUNNING"
+				if reads >= 3 {
+					state = "DONE"
+				}
+
+				return []string{fmt.Sprintf(testEventJSON, 3, 3, "isabell.example", "isabell.example", state), other, old}
+			},
+			wantReads: 3,
+		},
+		{
+			name: "failed",
+			next: func(int) []string {
+				return []string{fmt.Sprintf(testEventJSON, 3, 3, "isabell.example", "isabell.example", "FAILED"), other, old}
+			},
+			wantErr:   "certificate request failed",
+			wantReads: 1,
+		},
+		{
+			name: "no event",
+			next: func(int) []string {
+				return []string{other, old}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := &testEvents{events: []string{old}, next: tt.next}
+			waiter, c := testEventWaiter(t, events)
+
+			marker, err := waiter.Mark(t.Context(), c, "isabell")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = waiter.Wait(t.Context(), c, "isabell", marker, EventObject{ContentType: contentTypeWebDomain, Names: []string{"isabell.example"}})
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "":
+				var failed *EventFailedError
+				if !errors.As(err, &failed) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want event failure containing %q", err, tt.wantErr)
+				}
+			}
+
+			if tt.wantReads != 0 && events.reads != tt.wantReads {
+				t.Errorf("event list reads = %d, want %d", events.reads, tt.wantReads)
+			}
+		})
+	}
+}
+
+func TestEventWaiterAPIKey(t *testing.T) {
+	var keys []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count":1,"next":null,"previous":null,"results":[%s]}`, fmt.Sprintf(testEventJSON, 1, 1, "isabell.example", "isabell.example", "DONE"))
+	}))
+	t.Cleanup(server.Close)
+
+	apikeys := APIKeys{Default: "default", Asteroids: map[string]string{"isabell": "isabell"}}
+
+	c, err := client.NewClient(server.URL, client.WithClient(NewAuthClient(apikeys, "terraform-provider-uberspace/test", server.Client())))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waiter := NewEventWaiter(true)
+	waiter.gracePeriod = 0
+
+	marker, err := waiter.Mark(t.Context(), c, "isabell")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := waiter.Wait(t.Context(), c, "isabell", marker, EventObject{ContentType: contentTypeWebDomain, Names: []string{"isabell.example"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(keys) == 0 {
+		t.Fatal("no events read")
+	}
+
+	for _, key := range keys {
+		if key != "Api-Key isabell" {
+			t.Errorf("events read with %q, want the API key of the asteroid", key)
+		}
+	}
+}
+
+func TestEventWaiterDisabled(t *testing.T) {
+	waiter := NewEventWaiter(false)
+
+	// a disabled waiter never touches the client
+	marker, err := waiter.Mark(t.Context(), nil, "isabell")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := waiter.Wait(t.Context(), nil, "isabell", marker, EventObject{ContentType: contentTypeSSHKey, ID: 1}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestNewEventsOrder serves events that are not sorted by pk. Events newer
+// than the marker after an older one are still found and paging stops at the
+// first page without newer events.
+func TestNewEventsOrder(t *testing.T) {
+	pages := [][]int{{103, 100, 105}, {104, 99}, {98, 97}, {106}}
+
+	var offsets []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/common/events/", func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		var events []string
+
+		next := "null"
+
+		for i, start := 0, 0; i < len(pages); start, i = start+len(pages[i]), i+1 {
+			if strconv.Itoa(start) != offset {
+				continue
+			}
+
+			for _, pk := range pages[i] {
+				events = append(events, fmt.Sprintf(testEventJSON, pk, pk, "isabell.example", "isabell.example", "DONE"))
+			}
+
+			if i < len(pages)-1 {
+				next = `"https://marvin.uberspace.is/more"`
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count":8,"next":%s,"previous":null,"results":[%s]}`, next, strings.Join(events, ","))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, client.WithClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := newEvents(t.Context(), c, EventMarker{pk: 100}, EventObject{ContentType: contentTypeWebDomain, Names: []string{"isabell.example"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]int, 0, len(events))
+	for _, event := range events {
+		got = append(got, event.Pk)
+	}
+
+	if fmt.Sprint(got) != "[103 105 104]" {
+		t.Errorf("got events %v, want [103 105 104]", got)
+	}
+
+	if fmt.Sprint(offsets) != "[0 3 5]" {
+		t.Errorf("got offsets %v, want [0 3 5]", offsets)
+	}
+}
+
+func TestEventObjectMatches(t *testing.T) {
+	object := EventObject{ContentType: contentTypeWebDomain, ID: 7, Names: []string{"isabell.example"}}
+
+	tests := []struct {
+		name  string
+		event client.Event
+		want  bool
+	}{
+		{name: "name", event: client.Event{ContentType: "webdomain", ObjectName: client.NewNilString("isabell.example")}, want: true},
+		{name: "id", event: client.Event{ContentType: "webdomain", ObjectID: client.NewNilInt(7)}, want: true},
+		{name: "human identifier", event: client.Event{ContentType: "webdomain", HumanIdentifier: "isabell.example"}, want: true},
+		{name: "app label", event: client.Event{ContentType: "web.WebDomain", ObjectName: client.NewNilString("isabell.example")}, want: true},
+		{name: "other content type with same name", event: client.Event{ContentType: "maildomain", ObjectName: client.NewNilString("isabell.example")}},
+		{name: "other content type with same id", event: client.Event{ContentType: "webbackend", ObjectID: client.NewNilInt(7)}},
+		{name: "other object", event: client.Event{ContentType: "webdomain", ObjectName: client.NewNilString("other.example")}},
+	}
+
+	for _, tt := range tests {
+		if got := object.matches(tt.event); got != tt.want {
+			t.Errorf("%s: matches() = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
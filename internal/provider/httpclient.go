@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultRequestTimeout bounds a single request to the Uberspace API when no
+// timeout is configured.
+const DefaultRequestTimeout = 60 * time.Second
+
+// HTTPClientConfig configures the HTTP client used to talk to the Uberspace API.
+type HTTPClientConfig struct {
+	// Timeout is the overall timeout of a single request.
+	Timeout time.Duration
+	// ProxyURL overrides the proxy taken from the HTTPS_PROXY environment variable.
+	ProxyURL string
+	// CACertFile is a PEM bundle trusted in addition to the system roots.
+	CACertFile string
+	// ClientCertFile and ClientKeyFile are a PEM encoded TLS client certificate and key.
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// NewHTTPClient returns a http.Client configured according to cfg.
+func NewHTTPClient(cfg HTTPClientConfig) (*http.Client, error) {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default transport type %T", http.DefaultTransport)
+	}
+
+	transport = transport.Clone()
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.CACertFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		pem, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA certificate file %s", cfg.CACertFile)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultRequestTimeout
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}, nil
+}
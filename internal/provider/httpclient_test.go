@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("default timeout", func(t *testing.T) {
+		c, err := NewHTTPClient(HTTPClientConfig{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Timeout != DefaultRequestTimeout {
+			t.Errorf("Timeout = %s, want %s", c.Timeout, DefaultRequestTimeout)
+		}
+	})
+
+	t.Run("custom CA", func(t *testing.T) {
+		c, err := NewHTTPClient(HTTPClientConfig{Timeout: 5 * time.Second, CACertFile: caFile})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := c.Get(server.URL) //nolint:noctx
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNoContent {
+			t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusNoContent)
+		}
+	})
+
+	t.Run("missing CA file", func(t *testing.T) {
+		if _, err := NewHTTPClient(HTTPClientConfig{CACertFile: filepath.Join(t.TempDir(), "missing.pem")}); err == nil {
+			t.Error("expected error for missing CA file")
+		}
+	})
+
+	t.Run("invalid proxy URL", func(t *testing.T) {
+		if _, err := NewHTTPClient(HTTPClientConfig{ProxyURL: "://proxy"}); err == nil {
+			t.Error("expected error for invalid proxy URL")
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importAttribute maps an identity attribute to the state attribute Read
+// needs to find the object.
+type importAttribute struct {
+	Identity string
+	State    string
+	Int64    bool
+}
+
+// importState sets the state attributes of an imported resource from its
+// identity or, if given, from an import ID of the identity attributes joined
+// by slashes. The last attribute may contain slashes itself, e.g. a path.
+func importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes []importAttribute) {
+	if req.ID == "" {
+		for _, a := range attributes {
+			var value attr.Value
+
+			if a.Int64 {
+				var v types.Int64
+
+				resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(a.Identity), &v)...)
+				value = v
+			} else {
+				var v types.String
+
+				resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(a.Identity), &v)...)
+				value = v
+			}
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(a.State), value)...)
+		}
+
+		return
+	}
+
+	parts := strings.SplitN(req.ID, "/", len(attributes))
+
+	names := make([]string, len(attributes))
+	for i, a := range attributes {
+		names[i] = a.Identity
+	}
+
+	if len(parts) != len(attributes) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(names, "/"), req.ID),
+		)
+
+		return
+	}
+
+	for i, a := range attributes {
+		if parts[i] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: %s. Got empty %s in %q", strings.Join(names, "/"), a.Identity, req.ID),
+			)
+
+			return
+		}
+
+		var value attr.Value = types.StringValue(parts[i])
+
+		if a.Int64 {
+			v, err := strconv.ParseInt(parts[i], 10, 64)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unexpected Import Identifier",
+					fmt.Sprintf("Expected %s to be a number, got %q", a.Identity, parts[i]),
+				)
+
+				return
+			}
+
+			value = types.Int64Value(v)
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(a.State), value)...)
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportState(t *testing.T) {
+	ctx := t.Context()
+
+	var schemaResp resource.SchemaResponse
+
+	(&WebdomainBackendResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	var identityResp resource.IdentitySchemaResponse
+
+	(&WebdomainBackendResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+
+	tests := []struct {
+		name     string
+		id       string
+		identity map[string]string
+		want     [3]string
+		wantErr  string
+	}{
+		{
+			name: "id",
+			id:   "isabell/isabell.example//api/v1",
+			want: [3]string{"isabell", "isabell.example", "/api/v1"},
+		},
+		{
+			name:     "identity",
+			identity: map[string]string{"asteroid": "isabell", "domain": "isabell.example", "path": "/"},
+			want:     [3]string{"isabell", "isabell.example", "/"},
+		},
+		{
+			name:    "missing part",
+			id:      "isabell/isabell.example",
+			wantErr: "asteroid/domain/path",
+		},
+		{
+			name:    "empty part",
+			id:      "isabell//api",
+			wantErr: "empty domain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ImportStateRequest{ID: tt.id}
+
+			if tt.identity != nil {
+				values := map[string]tftypes.Value{}
+				for name, value := range tt.identity {
+					values[name] = tftypes.NewValue(tftypes.String, value)
+				}
+
+				req.Identity = &tfsdk.ResourceIdentity{
+					Schema: identityResp.IdentitySchema,
+					Raw:    tftypes.NewValue(identityType, values),
+				}
+			}
+
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			(&WebdomainBackendResource{}).ImportState(ctx, req, &resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("got diagnostics %v, want error containing %q", resp.Diagnostics, tt.wantErr)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var model webdomainBackendModel
+			if diags := resp.State.Get(ctx, &model); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			got := [3]string{model.Asteroid.ValueString(), model.Domain.ValueString(), model.Path.ValueString()}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportStateInt64(t *testing.T) {
+	ctx := t.Context()
+
+	var schemaResp resource.SchemaResponse
+
+	(&SshkeyResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for id, wantErr := range map[string]bool{"isabell/42": false, "isabell/key": true} {
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+
+		(&SshkeyResource{}).ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Fatalf("%s: got diagnostics %v", id, resp.Diagnostics)
+		}
+
+		if wantErr {
+			continue
+		}
+
+		var model sshkeyModel
+		if diags := resp.State.Get(ctx, &model); diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		if model.Asteroid.ValueString() != "isabell" || model.Id.ValueInt64() != 42 {
+			t.Errorf("%s: got asteroid %s and id %s", id, model.Asteroid, model.Id)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// DefaultMaxConcurrentRequests serializes modifying requests per asteroid.
+const DefaultMaxConcurrentRequests = 1
+
+// ConcurrencyLimiter limits the number of concurrent modifying requests per
+// asteroid. Marvin processes changes as queued jobs per asteroid, so parallel
+// creates for the same asteroid race each other and get throttled. Reading
+// requests are passed through unlimited.
+type ConcurrencyLimiter struct {
+	next  ht.Client
+	limit int
+
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func NewConcurrencyLimiter(next ht.Client, limit int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		next:  next,
+		limit: max(limit, 1),
+		slots: map[string]chan struct{}{},
+	}
+}
+
+func (l *ConcurrencyLimiter) Do(r *http.Request) (*http.Response, error) {
+	asteroid := asteroidFromPath(r.URL.Path)
+	if asteroid == "" || !isModifying(r.Method) {
+		return l.next.Do(r)
+	}
+
+	slots := l.slotsFor(asteroid)
+
+	select {
+	case slots <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+
+	defer func() { <-slots }()
+
+	return l.next.Do(r)
+}
+
+func (l *ConcurrencyLimiter) slotsFor(asteroid string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	slots, ok := l.slots[asteroid]
+	if !ok {
+		slots = make(chan struct{}, l.limit)
+		l.slots[asteroid] = slots
+	}
+
+	return slots
+}
+
+// asteroidFromPath returns the asteroid of an API path like
+// /api/v1/external/asteroids/{asteroid_name}/..., or "" if there is none.
+func asteroidFromPath(p string) string {
+	_, rest, ok := strings.Cut(p, "/asteroids/")
+	if !ok {
+		return ""
+	}
+
+	asteroid, _, _ := strings.Cut(rest, "/")
+
+	return asteroid
+}
+
+func isModifying(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
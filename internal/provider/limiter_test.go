@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAsteroidFromPath(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/external/asteroids/isabell/webdomains/":                      "isabell",
+		"/api/v1/external/asteroids/isabell/":                                 "isabell",
+		"/api/v1/external/asteroids/isabell":                                  "isabell",
+		"/api/v1/external/asteroids/isabell/maildomains/example.org/users/a/": "isabell",
+		"/api/v1/external/tools/":                                             "",
+	}
+
+	for p, want := range tests {
+		if got := asteroidFromPath(p); got != want {
+			t.Errorf("asteroidFromPath(%q) = %q, want %q", p, got, want)
+		}
+	}
+}
+
+// countingClient records the maximum number of requests in flight.
+type countingClient struct {
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (c *countingClient) Do(*http.Request) (*http.Response, error) {
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+
+	for {
+		current := c.maxInFlight.Load()
+		if n <= current || c.maxInFlight.CompareAndSwap(current, n) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		limit  int
+		paths  []string
+		want   int32
+	}{
+		{
+			name:   "serializes writes per asteroid",
+			method: http.MethodPost,
+			limit:  1,
+			paths:  []string{"/api/v1/external/asteroids/isabell/webdomains/"},
+			want:   1,
+		},
+		{
+			name:   "respects higher limit",
+			method: http.MethodPost,
+			limit:  2,
+			paths:  []string{"/api/v1/external/asteroids/isabell/webdomains/"},
+			want:   2,
+		},
+		{
+			name:   "asteroids are independent",
+			method: http.MethodDelete,
+			limit:  1,
+			paths:  []string{"/api/v1/external/asteroids/isabell/webdomains/a/", "/api/v1/external/asteroids/terra/webdomains/b/"},
+			want:   2,
+		},
+		{
+			name:   "reads are not limited",
+			method: http.MethodGet,
+			limit:  1,
+			paths:  []string{"/api/v1/external/asteroids/isabell/webdomains/"},
+			want:   5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &countingClient{}
+			limiter := NewConcurrencyLimiter(next, tt.limit)
+
+			var wg sync.WaitGroup
+
+			for _, p := range tt.paths {
+				for range 5 {
+					wg.Go(func() {
+						req, err := http.NewRequestWithContext(t.Context(), tt.method, "https://marvin.uberspace.is"+p, nil)
+						if err != nil {
+							t.Error(err)
+							return
+						}
+
+						resp, err := limiter.Do(req)
+						if err != nil {
+							t.Error(err)
+							return
+						}
+
+						_ = resp.Body.Close()
+					})
+				}
+			}
+
+			wg.Wait()
+
+			if got := next.maxInFlight.Load(); got > tt.want || (tt.want > 1 && got < 2) {
+				t.Errorf("max in flight = %d, want at most %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// listConfigModel describes the configuration shared by all list resources.
+type listConfigModel struct {
+	Asteroid types.String `tfsdk:"asteroid"`
+}
+
+func listConfigSchema(description string) schema.Schema {
+	return schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"asteroid": schema.StringAttribute{
+				Description: "Name of a hosting account, e.g. 'isabell'.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// listClient returns the API client handed over by the provider.
+func listClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *client.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return data.Client
+}
+
+// listResults streams one list result per item returned by fetch, at most
+// req.Limit of them. setResult sets the identity, display name and, if
+// requested, the resource of a result. It returns false to skip items that
+// cannot be imported.
+func listResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	summary string,
+	fetch pagination.FetchFunc[T],
+	setResult func(ctx context.Context, item *T, result *list.ListResult) bool,
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+
+		for item, err := range pagination.All(ctx, fetch) {
+			if err != nil {
+				var diags diag.Diagnostics
+
+				addClientError(&diags, summary, err)
+				push(list.ListResult{Diagnostics: diags})
+
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			if !setResult(ctx, &item, &result) {
+				continue
+			}
+
+			if !push(result) {
+				return
+			}
+
+			count++
+
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testWebdomainJSON = `{"name":%[1]q,"name_display":%[1]q,"name_idn":%[1]q,"dns_validation_token":"token","dns_state":"VALID","dns_last_check":null,"dns_error":null,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","asteroid":"isabell"}`
+
+func TestListResourceSchemas(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas, err := server.GetProviderSchema(t.Context(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range schemas.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(t.Context(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range identities.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	for name := range schemas.ListResourceSchemas {
+		if _, ok := identities.IdentitySchemas[name]; !ok {
+			t.Errorf("%s: list resource without identity schema", name)
+		}
+	}
+
+	if len(schemas.ListResourceSchemas) != len(schemas.ResourceSchemas) {
+		t.Errorf("got %d list resources, want one per resource (%d)", len(schemas.ListResourceSchemas), len(schemas.ResourceSchemas))
+	}
+}
+
+func TestWebdomainListResource(t *testing.T) {
+	ctx := t.Context()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/external/asteroids/{asteroid}/webdomains/", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count":2,"next":null,"previous":null,"results":[%s,%s]}`,
+			fmt.Sprintf(testWebdomainJSON, "isabell.example"),
+			fmt.Sprintf(testWebdomainJSON, "www.isabell.example"),
+		)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	r := &WebdomainListResource{}
+
+	var configureResp resource.ConfigureResponse
+
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: testProviderConfigure(t, server.URL).ListResourceData}, &configureResp)
+
+	if configureResp.Diagnostics.HasError() {
+		t.Fatal(configureResp.Diagnostics)
+	}
+
+	var configSchema list.ListResourceSchemaResponse
+
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+
+	var resourceSchema resource.SchemaResponse
+
+	(&WebdomainResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	var identitySchema resource.IdentitySchemaResponse
+
+	(&WebdomainResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	config := tfsdk.Config{
+		Schema: configSchema.Schema,
+		Raw: tftypes.NewValue(configSchema.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"asteroid": tftypes.NewValue(tftypes.String, "isabell"),
+		}),
+	}
+
+	tests := []struct {
+		name            string
+		limit           int64
+		includeResource bool
+		want            []string
+	}{
+		{name: "all", want: []string{"isabell.example", "www.isabell.example"}},
+		{name: "limit", limit: 1, want: []string{"isabell.example"}},
+		{name: "include resource", includeResource: true, want: []string{"isabell.example", "www.isabell.example"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := list.ListRequest{
+				Config:                 config,
+				IncludeResource:        tt.includeResource,
+				Limit:                  tt.limit,
+				ResourceSchema:         resourceSchema.Schema,
+				ResourceIdentitySchema: identitySchema.IdentitySchema,
+			}
+
+			var stream list.ListResultsStream
+
+			r.List(ctx, req, &stream)
+
+			var got []string
+
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatal(result.Diagnostics)
+				}
+
+				var identity webdomainIdentityModel
+				if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+					t.Fatal(diags)
+				}
+
+				if identity.Asteroid.ValueString() != "isabell" || identity.Name.ValueString() != result.DisplayName {
+					t.Errorf("identity = %v, want asteroid isabell and name %q", identity, result.DisplayName)
+				}
+
+				if tt.includeResource {
+					var model webdomainModel
+					if diags := result.Resource.Get(ctx, &model); diags.HasError() {
+						t.Fatal(diags)
+					}
+
+					if model.Name != identity.Name || model.DnsState.ValueString() != "VALID" {
+						t.Errorf("resource = %v, want name %s and dns state VALID", model, identity.Name)
+					}
+				}
+
+				got = append(got, identity.Name.ValueString())
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebdomainBackendListResource(t *testing.T) {
+	ctx := t.Context()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/external/asteroids/{asteroid}/webbackends/", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count":2,"next":null,"previous":null,"results":[%s,%s]}`,
+			`{"pk":1,"asteroid":"isabell","domain":null,"path":"/","remove_prefix":false,"destination":"STATIC","port":null,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`,
+			fmt.Sprintf(testBackendJSON, 2, "/blog"),
+		)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	r := &WebdomainBackendListResource{}
+
+	var configureResp resource.ConfigureResponse
+
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: testProviderConfigure(t, server.URL).ListResourceData}, &configureResp)
+
+	var configSchema list.ListResourceSchemaResponse
+
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+
+	var resourceSchema resource.SchemaResponse
+
+	(&WebdomainBackendResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	var identitySchema resource.IdentitySchemaResponse
+
+	(&WebdomainBackendResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchema.Schema,
+			Raw: tftypes.NewValue(configSchema.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"asteroid": tftypes.NewValue(tftypes.String, "isabell"),
+			}),
+		},
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}
+
+	var stream list.ListResultsStream
+
+	r.List(ctx, req, &stream)
+
+	var got []string
+
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatal(result.Diagnostics)
+		}
+
+		var identity webdomainBackendIdentityModel
+		if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		got = append(got, identity.Domain.ValueString()+identity.Path.ValueString())
+	}
+
+	// the default backend without a domain is skipped
+	if want := []string{"isabell.uber.space/blog"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogSubsystem is the tflog subsystem for requests sent to the Uberspace
+// API. It follows TF_LOG_PROVIDER_UBERSPACE and can be tuned separately with
+// TF_LOG_PROVIDER_UBERSPACE_API.
+const apiLogSubsystem = "api"
+
+var passwordHashPattern = regexp.MustCompile(`("password_hash"\s*:\s*)"[^"]*"`)
+
+// newAPILogContext sets up the api logging subsystem on the request context.
+func newAPILogContext(ctx context.Context, r *http.Request) context.Context {
+	ctx = tflog.NewSubsystem(ctx, apiLogSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_UBERSPACE", "API"),
+		tflog.WithRootFields(),
+	)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, apiLogSubsystem, "password_hash")
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, "http_method", r.Method)
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, "http_path", r.URL.Path)
+
+	return ctx
+}
+
+// logRequest logs an outgoing API request. The body is only logged on trace
+// level with password hashes redacted.
+func logRequest(ctx context.Context, r *http.Request) {
+	fields := map[string]any{
+		"http_request_headers": redactHeaders(r.Header),
+	}
+
+	if body := peekBody(&r.Body); len(body) > 0 {
+		fields["http_request_body"] = redactBody(body)
+	}
+
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "Sending API request", fields)
+}
+
+// logResponse logs the outcome of an API request.
+func logResponse(ctx context.Context, resp *http.Response, err error, duration time.Duration) {
+	fields := map[string]any{
+		"http_duration_ms": duration.Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "API request failed", fields)
+
+		return
+	}
+
+	fields["http_status_code"] = resp.StatusCode
+
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		fields["http_request_id"] = requestID
+	}
+
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Received API response", fields)
+
+	if body := peekBody(&resp.Body); len(body) > 0 {
+		tflog.SubsystemTrace(ctx, apiLogSubsystem, "API response body", map[string]any{
+			"http_response_body": redactBody(body),
+		})
+	}
+}
+
+// peekBody reads the whole body and replaces it with a reader of the same
+// content, so it can still be consumed by the caller.
+func peekBody(body *io.ReadCloser) []byte {
+	if *body == nil || *body == http.NoBody {
+		return nil
+	}
+
+	buf, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(buf))
+
+	if err != nil {
+		return nil
+	}
+
+	return buf
+}
+
+// redactHeaders returns a copy of the headers with credentials masked.
+func redactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+
+	if redacted.Get("Authorization") != "" {
+		redacted.Set("Authorization", "Api-Key ***")
+	}
+
+	return redacted
+}
+
+// redactBody masks password hashes in a JSON body.
+func redactBody(body []byte) string {
+	return passwordHashPattern.ReplaceAllString(string(body), `$1"***"`)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Api-Key secret")
+	header.Set("Content-Type", "application/json")
+
+	redacted := redactHeaders(header)
+
+	if got := redacted.Get("Authorization"); got != "Api-Key ***" {
+		t.Errorf("Authorization = %q, want %q", got, "Api-Key ***")
+	}
+
+	if got := redacted.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want %q", got, "application/json")
+	}
+
+	if got := header.Get("Authorization"); got != "Api-Key secret" {
+		t.Errorf("original Authorization header was modified: %q", got)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	body := `{"name":"isabell","password_hash": "$6$salt$hash","keep_forwards":false}`
+	want := `{"name":"isabell","password_hash": "***","keep_forwards":false}`
+
+	if got := redactBody([]byte(body)); got != want {
+		t.Errorf("redactBody() = %q, want %q", got, want)
+	}
+}
+
+func TestPeekBody(t *testing.T) {
+	body := io.NopCloser(strings.NewReader("content"))
+
+	if got := string(peekBody(&body)); got != "content" {
+		t.Errorf("peekBody() = %q, want %q", got, "content")
+	}
+
+	rest, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(rest) != "content" {
+		t.Errorf("body after peek = %q, want %q", rest, "content")
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/mailpassword"
+)
+
+const (
+	// DefaultMailPasswordLength is the length of generated mailbox passwords.
+	DefaultMailPasswordLength = 24
+
+	// minMailPasswordLength keeps generated passwords above 70 bits.
+	minMailPasswordLength = 12
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &MailPasswordEphemeralResource{}
+
+func NewMailPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &MailPasswordEphemeralResource{}
+}
+
+// MailPasswordEphemeralResource generates a mailbox password and its hash
+// without storing either in state.
+type MailPasswordEphemeralResource struct{}
+
+type mailPasswordModel struct {
+	Length       types.Int64  `tfsdk:"length"`
+	Password     types.String `tfsdk:"password"`
+	PasswordHash types.String `tfsdk:"password_hash"`
+}
+
+func (e *MailPasswordEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_password"
+}
+
+func (e *MailPasswordEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a random mailbox password and its SHA512-CRYPT hash. Use the hash with the write-only `password_hash_wo` attribute of `uberspace_mailuser` to keep both out of state.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Description: fmt.Sprintf("Length of the password. Defaults to `%d`.", DefaultMailPasswordLength),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(minMailPasswordLength),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The generated password.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"password_hash": schema.StringAttribute{
+				Description: "SHA512-CRYPT hash of the password with a random salt.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (e *MailPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data mailPasswordModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := DefaultMailPasswordLength
+	if !data.Length.IsNull() {
+		length = int(data.Length.ValueInt64())
+	}
+
+	password, err := mailpassword.Generate(length)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to generate mail password", err.Error())
+		return
+	}
+
+	hash, err := mailpassword.Hash(password, mailpassword.NewSalt())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to hash mail password", err.Error())
+		return
+	}
+
+	data.Password = types.StringValue(password)
+	data.PasswordHash = types.StringValue(hash)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/mailpassword"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MailPasswordHashFunction{}
+
+func NewMailPasswordHashFunction() function.Function {
+	return &MailPasswordHashFunction{}
+}
+
+// MailPasswordHashFunction hashes a mailbox password for the password_hash
+// attribute of uberspace_mailuser.
+type MailPasswordHashFunction struct{}
+
+func (f *MailPasswordHashFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mail_password_hash"
+}
+
+func (f *MailPasswordHashFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Hash a mailbox password",
+		Description: "Returns the SHA512-CRYPT hash of a password for the `password_hash` attribute of `uberspace_mailuser`. The same password and salt always give the same hash.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "password",
+				Description: "Password to hash.",
+			},
+			function.StringParameter{
+				Name:        "salt",
+				Description: "Salt of 1 to 16 characters of `[./0-9A-Za-z]`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MailPasswordHashFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var password, salt string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &password, &salt))
+	if resp.Error != nil {
+		return
+	}
+
+	hash, err := mailpassword.Hash(password, salt)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, hash))
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &MaildomainListResource{}
+	_ list.ListResourceWithConfigure = &MaildomainListResource{}
+)
+
+func NewMaildomainListResource() list.ListResource {
+	return &MaildomainListResource{}
+}
+
+// MaildomainListResource lists the mail domains of an asteroid.
+type MaildomainListResource struct {
+	client *client.Client
+}
+
+func (r *MaildomainListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maildomain"
+}
+
+func (r *MaildomainListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("Lists the mail domains of an asteroid.")
+}
+
+func (r *MaildomainListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = listClient(req, resp)
+}
+
+func (r *MaildomainListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listConfigModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	fetch := func(ctx context.Context, limit, offset int) (pagination.Page[client.MailDomain], error) {
+		return r.client.AsteroidsMaildomainsList(ctx, client.AsteroidsMaildomainsListParams{
+			AsteroidName: config.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	}
+
+	stream.Results = listResults(ctx, req, "Unable to read mail domains", fetch, func(ctx context.Context, maildomain *client.MailDomain, result *list.ListResult) bool {
+		model := maildomainModel{Timeouts: nullTimeouts()}
+		model.fromAPI(maildomain)
+		model.Name = types.StringValue(maildomain.Name)
+
+		result.DisplayName = maildomain.NameDisplay
+		result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		}
+
+		return true
+	})
+}
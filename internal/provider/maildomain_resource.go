@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
@@ -14,17 +17,67 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &MaildomainResource{}
-	// _ resource.ResourceWithImportState = &MaildomainResource{}.
+	_ resource.Resource                 = &MaildomainResource{}
+	_ resource.ResourceWithIdentity     = &MaildomainResource{}
+	_ resource.ResourceWithImportState  = &MaildomainResource{}
+	_ resource.ResourceWithUpgradeState = &MaildomainResource{}
 )
 
+// maildomainModel extends the generated model with the timeouts block.
+type maildomainModel struct {
+	resource_maildomain.MaildomainModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// maildomainIdentityModel describes the resource identity.
+type maildomainIdentityModel struct {
+	Asteroid types.String `tfsdk:"asteroid"`
+	Name     types.String `tfsdk:"name"`
+}
+
+func (m *maildomainModel) identity() maildomainIdentityModel {
+	return maildomainIdentityModel{
+		Asteroid: m.Asteroid,
+		Name:     m.Name,
+	}
+}
+
+// fromAPI sets the model from a mail domain returned by the API.
+func (m *maildomainModel) fromAPI(maildomain *client.MailDomain) {
+	m.Asteroid = types.StringValue(maildomain.Asteroid)
+	m.AsteroidName = types.StringValue(maildomain.Asteroid)
+	m.CreatedAt = types.StringValue(maildomain.CreatedAt.Format(time.RFC3339))
+	m.DnsValidationToken = types.StringValue(maildomain.DNSValidationToken)
+
+	m.DnsState = types.StringValue(string(maildomain.DNSState))
+	if lastCheck, ok := maildomain.DNSLastCheck.Get(); ok {
+		m.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
+	} else {
+		m.DnsLastCheck = types.StringNull()
+	}
+
+	if maildomain.DNSError.IsNull() {
+		m.DnsError = types.StringNull()
+	} else {
+		m.DnsError = types.StringValue(maildomain.DNSError.Or(""))
+	}
+
+	m.Format = types.StringValue("json")
+	m.Name = types.StringValue(maildomain.Name)
+	m.NameDisplay = types.StringValue(maildomain.NameDisplay)
+	m.NameIdn = types.StringValue(maildomain.NameIdn)
+	m.UpdatedAt = types.StringValue(maildomain.UpdatedAt.Format(time.RFC3339))
+}
+
 func NewMaildomainResource() resource.Resource {
 	return &MaildomainResource{}
 }
 
 // MaildomainResource defines the resource implementation.
 type MaildomainResource struct {
-	client *client.Client
+	client        *client.Client
+	events        *EventWaiter
+	adoptExisting bool
 }
 
 func (r *MaildomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -32,7 +85,23 @@ func (r *MaildomainResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 func (r *MaildomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_maildomain.MaildomainResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, resource_maildomain.MaildomainResourceSchema(ctx))
+	resp.Schema.Version = 1
+}
+
+func (r *MaildomainResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"asteroid": identityschema.StringAttribute{
+				Description:       "Name of the asteroid the mail domain belongs to.",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the mail domain.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *MaildomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -40,22 +109,24 @@ func (r *MaildomainResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *uberspace.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.events = data.Events
+	r.adoptExisting = data.AdoptExisting
 }
 
 func (r *MaildomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_maildomain.MaildomainModel
+	var plan maildomainModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -63,6 +134,22 @@ func (r *MaildomainResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	apiReq := client.AsteroidsMaildomainsCreateApplicationJSON(client.MailDomainRequest{
 		Name:     plan.Name.ValueString(),
 		Asteroid: plan.Asteroid.ValueString(),
@@ -71,40 +158,35 @@ func (r *MaildomainResource) Create(ctx context.Context, req resource.CreateRequ
 	Maildomain, err := r.client.AsteroidsMaildomainsCreate(ctx, &apiReq, client.AsteroidsMaildomainsCreateParams{
 		AsteroidName: plan.Asteroid.ValueString(),
 	})
+	adopted := false
+	if err != nil && r.adoptExisting && isConflict(err) {
+		adopted = true
+		Maildomain, err = r.client.AsteroidsMaildomainsGet(ctx, client.AsteroidsMaildomainsGetParams{
+			AsteroidName: plan.Asteroid.ValueString(),
+			Name:         plan.Name.ValueString(),
+		})
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create mail domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create mail domain", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(Maildomain.Asteroid)
-	plan.AsteroidName = types.StringValue(Maildomain.Asteroid)
-	plan.CreatedAt = types.StringValue(Maildomain.CreatedAt.Format(time.RFC3339))
-	plan.DnsValidationToken = types.StringValue(Maildomain.DNSValidationToken)
+	plan.fromAPI(Maildomain)
 
-	plan.DnsState = types.StringValue(string(Maildomain.DNSState))
-	if lastCheck, ok := Maildomain.DNSLastCheck.Get(); ok {
-		plan.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
-	} else {
-		plan.DnsLastCheck = types.StringNull()
+	// adopting triggers no event to wait for
+	if !adopted {
+		if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeMailDomain, Names: []string{Maildomain.Name}}); err != nil {
+			addClientError(&resp.Diagnostics, "Unable to create mail domain", err)
+		}
 	}
 
-	if Maildomain.DNSError.IsNull() {
-		plan.DnsError = types.StringNull()
-	} else {
-		plan.DnsError = types.StringValue(Maildomain.DNSError.Or(""))
-	}
-
-	plan.Format = types.StringValue("json")
-	plan.NameIdn = types.StringValue(Maildomain.Name)
-	plan.NameDisplay = types.StringValue(Maildomain.NameDisplay)
-	plan.NameIdn = types.StringValue(Maildomain.NameIdn)
-	plan.UpdatedAt = types.StringValue(Maildomain.UpdatedAt.Format(time.RFC3339))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *MaildomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_maildomain.MaildomainModel
+	var state maildomainModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -112,44 +194,33 @@ func (r *MaildomainResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	Maildomain, err := r.client.AsteroidsMaildomainsGet(ctx, client.AsteroidsMaildomainsGetParams{
 		AsteroidName: state.Asteroid.ValueString(),
 		Name:         state.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read mail domain", err)
 		return
 	}
 
-	state.Asteroid = types.StringValue(Maildomain.Asteroid)
-	state.AsteroidName = types.StringValue(Maildomain.Asteroid)
-	state.CreatedAt = types.StringValue(Maildomain.CreatedAt.Format(time.RFC3339))
-	state.DnsValidationToken = types.StringValue(Maildomain.DNSValidationToken)
-
-	state.DnsState = types.StringValue(string(Maildomain.DNSState))
-	if lastCheck, ok := Maildomain.DNSLastCheck.Get(); ok {
-		state.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
-	} else {
-		state.DnsLastCheck = types.StringNull()
-	}
-
-	if Maildomain.DNSError.IsNull() {
-		state.DnsError = types.StringNull()
-	} else {
-		state.DnsError = types.StringValue(Maildomain.DNSError.Or(""))
-	}
-
-	state.Format = types.StringValue("json")
-	state.NameIdn = types.StringValue(Maildomain.Name)
-	state.NameDisplay = types.StringValue(Maildomain.NameDisplay)
-	state.NameIdn = types.StringValue(Maildomain.NameIdn)
-	state.UpdatedAt = types.StringValue(Maildomain.UpdatedAt.Format(time.RFC3339))
+	state.fromAPI(Maildomain)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *MaildomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state, plan resource_maildomain.MaildomainModel
+	var state, plan maildomainModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -158,10 +229,26 @@ func (r *MaildomainResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsMaildomainsDelete(ctx, client.AsteroidsMaildomainsDeleteParams{
 		AsteroidName: state.Asteroid.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete mail domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete mail domain", err)
 		return
 	}
 
@@ -174,39 +261,22 @@ func (r *MaildomainResource) Update(ctx context.Context, req resource.UpdateRequ
 		AsteroidName: plan.Asteroid.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create mail domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create mail domain", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(Maildomain.Asteroid)
-	plan.AsteroidName = types.StringValue(Maildomain.Asteroid)
-	plan.CreatedAt = types.StringValue(Maildomain.CreatedAt.Format(time.RFC3339))
-	plan.DnsValidationToken = types.StringValue(Maildomain.DNSValidationToken)
+	plan.fromAPI(Maildomain)
 
-	plan.DnsState = types.StringValue(string(Maildomain.DNSState))
-	if lastCheck, ok := Maildomain.DNSLastCheck.Get(); ok {
-		plan.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
-	} else {
-		plan.DnsLastCheck = types.StringNull()
-	}
-
-	if Maildomain.DNSError.IsNull() {
-		plan.DnsError = types.StringNull()
-	} else {
-		plan.DnsError = types.StringValue(Maildomain.DNSError.Or(""))
+	if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeMailDomain, Names: []string{Maildomain.Name}}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to update mail domain", err)
 	}
 
-	plan.Format = types.StringValue("json")
-	plan.NameIdn = types.StringValue(Maildomain.Name)
-	plan.NameDisplay = types.StringValue(Maildomain.NameDisplay)
-	plan.NameIdn = types.StringValue(Maildomain.NameIdn)
-	plan.UpdatedAt = types.StringValue(Maildomain.UpdatedAt.Format(time.RFC3339))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *MaildomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_maildomain.MaildomainModel
+	var state maildomainModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -214,11 +284,61 @@ func (r *MaildomainResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, state.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsMaildomainsDelete(ctx, client.AsteroidsMaildomainsDeleteParams{
 		AsteroidName: state.Asteroid.ValueString(),
 		Name:         state.Name.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete mail domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete mail domain", err)
 		return
 	}
+
+	if err := r.events.Wait(ctx, r.client, state.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeMailDomain, Names: []string{state.Name.ValueString()}}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to delete mail domain", err)
+	}
+}
+
+func (r *MaildomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []importAttribute{
+		{Identity: "asteroid", State: "asteroid"},
+		{Identity: "name", State: "name"},
+	})
+}
+
+func (r *MaildomainResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeState(maildomainSchemaV0, nil),
+	}
+}
+
+// maildomainSchemaV0 are the attribute types of the unversioned mail domain schema.
+var maildomainSchemaV0 = map[string]attr.Type{
+	"asteroid":             types.StringType,
+	"asteroid_name":        types.StringType,
+	"created_at":           types.StringType,
+	"dns_error":            types.StringType,
+	"dns_last_check":       types.StringType,
+	"dns_state":            types.StringType,
+	"dns_validation_token": types.StringType,
+	"format":               types.StringType,
+	"name":                 types.StringType,
+	"name_display":         types.StringType,
+	"name_idn":             types.StringType,
+	"updated_at":           types.StringType,
+	"timeouts":             timeoutsV0,
 }
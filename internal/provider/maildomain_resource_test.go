@@ -33,6 +33,12 @@ func TestAccMaildomainResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by identity testing
+			{
+				ResourceName:    "uberspace_maildomain.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{
 				Config: testAccMaildomainResourceConfig("terra", maildomain),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMaildomainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaildomainsDataSourceConfig("terra", "maillist.terra.uber.space"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uberspace_maildomains.test",
+						tfjsonpath.New("maildomains"),
+						knownvalue.ListPartial(map[int]knownvalue.Check{
+							0: knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"asteroid": knownvalue.StringExact("terra"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMaildomainsDataSourceConfig(asteroid, name string) string {
+	return fmt.Sprintf(`
+resource "uberspace_maildomain" "test" {
+  asteroid = %[1]q
+  name     = %[2]q
+}
+
+data "uberspace_maildomains" "test" {
+  asteroid = uberspace_maildomain.test.asteroid
+}
+`, asteroid, name)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &MailuserListResource{}
+	_ list.ListResourceWithConfigure = &MailuserListResource{}
+)
+
+func NewMailuserListResource() list.ListResource {
+	return &MailuserListResource{}
+}
+
+// MailuserListResource lists the mail users of an asteroid.
+type MailuserListResource struct {
+	client *client.Client
+}
+
+func (r *MailuserListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mailuser"
+}
+
+func (r *MailuserListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("Lists the mail users of an asteroid.")
+}
+
+func (r *MailuserListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = listClient(req, resp)
+}
+
+func (r *MailuserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listConfigModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	fetch := func(ctx context.Context, limit, offset int) (pagination.Page[client.MailUser], error) {
+		return r.client.AsteroidsMailusersList(ctx, client.AsteroidsMailusersListParams{
+			AsteroidName: config.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	}
+
+	stream.Results = listResults(ctx, req, "Unable to read mail users", fetch, func(ctx context.Context, mailuser *client.MailUser, result *list.ListResult) bool {
+		model := mailuserModel{Timeouts: nullTimeouts()}
+		result.Diagnostics.Append(model.fromAPI(ctx, mailuser)...)
+
+		if _, domain, err := parseMailaddr(mailuser.Mailaddr); err == nil {
+			model.MaildomainName = types.StringValue(domain)
+		}
+
+		result.DisplayName = mailuser.Mailaddr
+		result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		}
+
+		return true
+	})
+}
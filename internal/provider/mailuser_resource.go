@@ -5,9 +5,17 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
@@ -16,10 +24,73 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &MailuserResource{}
-	// _ resource.ResourceWithImportState = &MailuserResource{}.
+	_ resource.Resource                 = &MailuserResource{}
+	_ resource.ResourceWithIdentity     = &MailuserResource{}
+	_ resource.ResourceWithImportState  = &MailuserResource{}
+	_ resource.ResourceWithUpgradeState = &MailuserResource{}
 )
 
+// mailuserModel extends the generated model with the timeouts block and the
+// write-only password hash.
+type mailuserModel struct {
+	resource_mailuser.MailuserModel
+	PasswordHashWo        types.String   `tfsdk:"password_hash_wo"`
+	PasswordHashWoVersion types.Int64    `tfsdk:"password_hash_wo_version"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// setPasswordHash stores the password hash returned by the API, unless the
+// hash is managed by the write-only password_hash_wo attribute.
+func (m *mailuserModel) setPasswordHash(hash client.OptNilString) {
+	if !m.PasswordHashWoVersion.IsNull() {
+		m.PasswordHash = types.StringNull()
+		return
+	}
+
+	m.PasswordHash = types.StringValue(hash.Or(""))
+}
+
+// mailuserIdentityModel describes the resource identity.
+type mailuserIdentityModel struct {
+	Asteroid   types.String `tfsdk:"asteroid"`
+	Maildomain types.String `tfsdk:"maildomain"`
+	Local      types.String `tfsdk:"local"`
+}
+
+func (m *mailuserModel) identity() mailuserIdentityModel {
+	return mailuserIdentityModel{
+		Asteroid:   m.AsteroidName,
+		Maildomain: m.MaildomainName,
+		Local:      m.Local,
+	}
+}
+
+// fromAPI sets the model from a mail user returned by the API.
+func (m *mailuserModel) fromAPI(ctx context.Context, mailuser *client.MailUser) diag.Diagnostics {
+	m.Asteroid = types.StringValue(mailuser.Asteroid)
+	m.AsteroidName = types.StringValue(mailuser.Asteroid)
+	m.CreatedAt = types.StringValue(mailuser.CreatedAt.Format(time.RFC3339))
+	m.Format = types.StringValue("json")
+
+	lv, d := convertForwards(ctx, mailuser.Forwards)
+	if d.HasError() {
+		return d
+	}
+
+	m.Forwards = lv
+	m.IsCatchall = types.BoolValue(mailuser.IsCatchall.Or(false))
+	m.IsSysmail = types.BoolValue(mailuser.IsSysmail.Or(false))
+	m.KeepForwards = types.BoolValue(mailuser.KeepForwards.Or(false))
+	m.Local = types.StringValue(mailuser.Name)
+	m.Mailaddr = types.StringValue(mailuser.Mailaddr)
+	m.Name = types.StringValue(mailuser.Name)
+	m.setPasswordHash(mailuser.PasswordHash)
+	m.Pk = types.StringValue(mailuser.Pk)
+	m.UpdatedAt = types.StringValue(mailuser.UpdatedAt.Format(time.RFC3339))
+
+	return nil
+}
+
 func NewMailuserResource() resource.Resource {
 	return &MailuserResource{}
 }
@@ -27,6 +98,23 @@ func NewMailuserResource() resource.Resource {
 // MailuserResource defines the resource implementation.
 type MailuserResource struct {
 	client *client.Client
+	events *EventWaiter
+	cache  *ReadCache
+}
+
+// configuredPasswordHash returns the password hash to send on create, the
+// write-only hash takes precedence. Write-only values are only part of the
+// config.
+func configuredPasswordHash(ctx context.Context, config tfsdk.Config, plan mailuserModel) (client.OptNilString, diag.Diagnostics) {
+	var passwordHashWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("password_hash_wo"), &passwordHashWo)
+
+	if !passwordHashWo.IsNull() {
+		return client.NewOptNilString(passwordHashWo.ValueString()), diags
+	}
+
+	return client.NewOptNilString(plan.PasswordHash.ValueString()), diags
 }
 
 func (r *MailuserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -34,7 +122,47 @@ func (r *MailuserResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 func (r *MailuserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_mailuser.MailuserResourceSchema(ctx)
+	s := resource_mailuser.MailuserResourceSchema(ctx)
+
+	s.Attributes["password_hash_wo"] = schema.StringAttribute{
+		Description: "Write-only alternative to password_hash, e.g. from the uberspace_mail_password ephemeral resource. The hash is not stored in state. Requires Terraform 1.11 or later.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("password_hash")),
+			stringvalidator.AlsoRequires(path.MatchRoot("password_hash_wo_version")),
+		},
+	}
+	s.Attributes["password_hash_wo_version"] = schema.Int64Attribute{
+		Description: "Version of password_hash_wo. Terraform cannot detect changes of write-only attributes, change the version to send a new hash. The hash is also sent when another change recreates the mail user, so a hash from an ephemeral resource sets a new password then.",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("password_hash_wo")),
+		},
+	}
+
+	resp.Schema = withTimeouts(ctx, s)
+	resp.Schema.Version = 1
+}
+
+func (r *MailuserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"asteroid": identityschema.StringAttribute{
+				Description:       "Name of the asteroid the mail user belongs to.",
+				RequiredForImport: true,
+			},
+			"maildomain": identityschema.StringAttribute{
+				Description:       "Mail domain of the mail user.",
+				RequiredForImport: true,
+			},
+			"local": identityschema.StringAttribute{
+				Description:       "Local part of the mail address.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *MailuserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -42,18 +170,20 @@ func (r *MailuserResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *uberspace.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
+	r.events = data.Events
+	r.cache = data.Cache
 }
 
 // convertForwards converts a slice of client.NestedMailForward into a Terraform types.List
@@ -95,7 +225,7 @@ func convertForwards(ctx context.Context, forwards []client.NestedMailForward) (
 }
 
 func (r *MailuserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_mailuser.MailuserModel
+	var plan mailuserModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -103,9 +233,32 @@ func (r *MailuserResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.AsteroidName.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
+	passwordHash, diags := configuredPasswordHash(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiReq := client.AsteroidsMaildomainsUsersCreateApplicationJSON(client.MailUserRequest{
 		Name:         plan.Name.ValueString(),
-		PasswordHash: client.NewOptNilString(plan.PasswordHash.ValueString()),
+		PasswordHash: passwordHash,
 	})
 
 	Mailuser, err := r.client.AsteroidsMaildomainsUsersCreate(ctx, &apiReq, client.AsteroidsMaildomainsUsersCreateParams{
@@ -113,38 +266,26 @@ func (r *MailuserResource) Create(ctx context.Context, req resource.CreateReques
 		MaildomainName: plan.MaildomainName.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create mail user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create mail user", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(Mailuser.Asteroid)
-	plan.AsteroidName = types.StringValue(Mailuser.Asteroid)
-	plan.CreatedAt = types.StringValue(Mailuser.CreatedAt.Format(time.RFC3339))
-	plan.Format = types.StringValue("json")
+	resp.Diagnostics.Append(plan.fromAPI(ctx, Mailuser)...)
 
-	lv, d := convertForwards(ctx, Mailuser.Forwards)
-	if d.HasError() {
-		resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	plan.Forwards = lv
-
-	plan.IsCatchall = types.BoolValue(Mailuser.IsCatchall.Or(false))
-	plan.IsSysmail = types.BoolValue(Mailuser.IsSysmail.Or(false))
-	plan.KeepForwards = types.BoolValue(Mailuser.KeepForwards.Or(false))
-	plan.Local = types.StringValue(Mailuser.Name)
-	plan.Mailaddr = types.StringValue(Mailuser.Mailaddr)
-	plan.Name = types.StringValue(Mailuser.Name)
-	plan.PasswordHash = types.StringValue(Mailuser.PasswordHash.Or(""))
-	plan.Pk = types.StringValue(Mailuser.Pk)
-	plan.UpdatedAt = types.StringValue(Mailuser.UpdatedAt.Format(time.RFC3339))
+	if err := r.events.Wait(ctx, r.client, plan.AsteroidName.ValueString(), marker, EventObject{ContentType: contentTypeMailUser, Names: []string{Mailuser.Mailaddr, Mailuser.Pk}}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to create mail user", err)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *MailuserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_mailuser.MailuserModel
+	var state mailuserModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -152,43 +293,38 @@ func (r *MailuserResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	Mailuser, err := r.client.AsteroidsMaildomainsUsersGet(ctx, client.AsteroidsMaildomainsUsersGetParams{
+	readTimeout, diags := state.Timeouts.Read(ctx, DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	Mailuser, err := r.cache.MailUser(ctx, r.client, client.AsteroidsMaildomainsUsersGetParams{
 		AsteroidName:   state.AsteroidName.ValueString(),
 		Local:          state.Local.ValueString(),
 		MaildomainName: state.MaildomainName.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read mail user", err)
 		return
 	}
 
-	state.Asteroid = types.StringValue(Mailuser.Asteroid)
-	state.AsteroidName = types.StringValue(Mailuser.Asteroid)
-	state.CreatedAt = types.StringValue(Mailuser.CreatedAt.Format(time.RFC3339))
-	state.Format = types.StringValue("json")
+	resp.Diagnostics.Append(state.fromAPI(ctx, Mailuser)...)
 
-	lv, d := convertForwards(ctx, Mailuser.Forwards)
-	if d.HasError() {
-		resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Forwards = lv
-	state.IsCatchall = types.BoolValue(Mailuser.IsCatchall.Or(false))
-	state.IsSysmail = types.BoolValue(Mailuser.IsSysmail.Or(false))
-	state.KeepForwards = types.BoolValue(Mailuser.KeepForwards.Or(false))
-	state.Local = types.StringValue(Mailuser.Name)
-	state.Mailaddr = types.StringValue(Mailuser.Mailaddr)
-	state.Name = types.StringValue(Mailuser.Name)
-	state.PasswordHash = types.StringValue(Mailuser.PasswordHash.Or(""))
-	state.Pk = types.StringValue(Mailuser.Pk)
-	state.UpdatedAt = types.StringValue(Mailuser.UpdatedAt.Format(time.RFC3339))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *MailuserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state, plan resource_mailuser.MailuserModel
+	var state, plan mailuserModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -197,15 +333,43 @@ func (r *MailuserResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.AsteroidName.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsMaildomainsUsersDelete(ctx, client.AsteroidsMaildomainsUsersDeleteParams{
-		AsteroidName: state.AsteroidName.ValueString(),
+		AsteroidName:   state.AsteroidName.ValueString(),
+		MaildomainName: state.MaildomainName.ValueString(),
+		Local:          state.Local.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete mail user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete mail user", err)
+		return
+	}
+
+	// the mail user is recreated, so the configured hash is always sent,
+	// otherwise the new mailbox has no password
+	passwordHash, diags := configuredPasswordHash(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	apiReq := client.AsteroidsMaildomainsUsersCreateApplicationJSON(client.MailUserRequest{
-		Name: plan.Name.ValueString(),
+		Name:         plan.Name.ValueString(),
+		PasswordHash: passwordHash,
 	})
 
 	Mailuser, err := r.client.AsteroidsMaildomainsUsersCreate(ctx, &apiReq, client.AsteroidsMaildomainsUsersCreateParams{
@@ -213,38 +377,26 @@ func (r *MailuserResource) Update(ctx context.Context, req resource.UpdateReques
 		MaildomainName: plan.MaildomainName.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create mail user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create mail user", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(Mailuser.Asteroid)
-	plan.AsteroidName = types.StringValue(Mailuser.Asteroid)
-	plan.CreatedAt = types.StringValue(Mailuser.CreatedAt.Format(time.RFC3339))
-	plan.Format = types.StringValue("json")
+	resp.Diagnostics.Append(plan.fromAPI(ctx, Mailuser)...)
 
-	lv, d := convertForwards(ctx, Mailuser.Forwards)
-	if d.HasError() {
-		resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	plan.Forwards = lv
-
-	plan.IsCatchall = types.BoolValue(Mailuser.IsCatchall.Or(false))
-	plan.IsSysmail = types.BoolValue(Mailuser.IsSysmail.Or(false))
-	plan.KeepForwards = types.BoolValue(Mailuser.KeepForwards.Or(false))
-	plan.Local = types.StringValue(Mailuser.Name)
-	plan.Mailaddr = types.StringValue(Mailuser.Mailaddr)
-	plan.Name = types.StringValue(Mailuser.Name)
-	plan.PasswordHash = types.StringValue(Mailuser.PasswordHash.Or(""))
-	plan.Pk = types.StringValue(Mailuser.Pk)
-	plan.UpdatedAt = types.StringValue(Mailuser.UpdatedAt.Format(time.RFC3339))
+	if err := r.events.Wait(ctx, r.client, plan.AsteroidName.ValueString(), marker, EventObject{ContentType: contentTypeMailUser, Names: []string{Mailuser.Mailaddr, Mailuser.Pk}}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to update mail user", err)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *MailuserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_mailuser.MailuserModel
+	var state mailuserModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -252,12 +404,74 @@ func (r *MailuserResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, state.AsteroidName.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsMaildomainsUsersDelete(ctx, client.AsteroidsMaildomainsUsersDeleteParams{
 		AsteroidName:   state.AsteroidName.ValueString(),
 		MaildomainName: state.MaildomainName.ValueString(),
 		Local:          state.Local.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete mail user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete mail user", err)
 		return
 	}
+
+	if err := r.events.Wait(ctx, r.client, state.AsteroidName.ValueString(), marker, EventObject{ContentType: contentTypeMailUser, Names: []string{state.Mailaddr.ValueString(), state.Pk.ValueString()}}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to delete mail user", err)
+	}
+}
+
+func (r *MailuserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []importAttribute{
+		{Identity: "asteroid", State: "asteroid_name"},
+		{Identity: "maildomain", State: "maildomain_name"},
+		{Identity: "local", State: "local"},
+	})
+}
+
+func (r *MailuserResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeState(mailuserSchemaV0, nil),
+	}
 }
+
+// mailuserSchemaV0 are the attribute types of the unversioned mail user schema.
+var mailuserSchemaV0 = map[string]attr.Type{
+	"asteroid":                 types.StringType,
+	"asteroid_name":            types.StringType,
+	"created_at":               types.StringType,
+	"format":                   types.StringType,
+	"forwards":                 types.ListType{ElemType: mailuserForwardV0},
+	"is_catchall":              types.BoolType,
+	"is_sysmail":               types.BoolType,
+	"keep_forwards":            types.BoolType,
+	"local":                    types.StringType,
+	"mailaddr":                 types.StringType,
+	"maildomain_name":          types.StringType,
+	"name":                     types.StringType,
+	"password_hash":            types.StringType,
+	"password_hash_wo":         types.StringType,
+	"password_hash_wo_version": types.Int64Type,
+	"pk":                       types.StringType,
+	"updated_at":               types.StringType,
+	"timeouts":                 timeoutsV0,
+}
+
+// mailuserForwardV0 is the type of a forward in schema version 0.
+var mailuserForwardV0 = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"destination": types.StringType,
+	"keep":        types.BoolType,
+}}
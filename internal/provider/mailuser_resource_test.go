@@ -1,14 +1,23 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
 )
 
 func TestAccMailuserResource(t *testing.T) {
@@ -42,6 +51,12 @@ func TestAccMailuserResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by identity testing
+			{
+				ResourceName:    "uberspace_mailuser.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{
 				Config: testAccMailuserResourceConfig(asteroid, maildomain, username),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -83,3 +98,130 @@ resource "uberspace_mailuser" "test" {
 }
 `, asteroid, maildomain, username)
 }
+
+// TestMailuserResourceUpdatePasswordHash checks that a change recreating the
+// mail user, like a rename, sends the configured hash again.
+func TestMailuserResourceUpdatePasswordHash(t *testing.T) {
+	ctx := t.Context()
+
+	const hash = "$6$saltsalt$hash"
+
+	tests := []struct {
+		name   string
+		plan   map[string]tftypes.Value
+		config map[string]tftypes.Value
+	}{
+		{
+			name: "write-only",
+			plan: map[string]tftypes.Value{
+				"password_hash_wo_version": tftypes.NewValue(tftypes.Number, 1),
+			},
+			config: map[string]tftypes.Value{
+				"password_hash_wo":         tftypes.NewValue(tftypes.String, hash),
+				"password_hash_wo_version": tftypes.NewValue(tftypes.Number, 1),
+			},
+		},
+		{
+			name: "password_hash",
+			plan: map[string]tftypes.Value{
+				"password_hash": tftypes.NewValue(tftypes.String, hash),
+			},
+			config: map[string]tftypes.Value{
+				"password_hash": tftypes.NewValue(tftypes.String, hash),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				deleted string
+				created client.MailUserRequest
+			)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("DELETE /api/v1/external/asteroids/{asteroid}/maildomains/{maildomain}/users/{local}/", func(w http.ResponseWriter, r *http.Request) {
+				deleted = r.PathValue("local") + "@" + r.PathValue("maildomain")
+
+				w.WriteHeader(http.StatusNoContent)
+			})
+			mux.HandleFunc("POST /api/v1/external/asteroids/{asteroid}/maildomains/{maildomain}/users/", func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+					t.Error(err)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintf(w, `{"pk":"2","asteroid":"isabell","name":%[1]q,"password_hash":%[2]q,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","mailaddr":"%[1]s@isabell.example","forwards":[]}`,
+					created.Name, created.PasswordHash.Or(""))
+			})
+
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			c, err := client.NewClient(server.URL, client.WithClient(server.Client()))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := &MailuserResource{client: c, events: NewEventWaiter(false)}
+
+			var schemaResp fwresource.SchemaResponse
+
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			var identityResp fwresource.IdentitySchemaResponse
+
+			r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identityResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+			// value returns a mail user of the given name, unset attributes are null
+			value := func(name string, attributes map[string]tftypes.Value) tftypes.Value {
+				values := map[string]tftypes.Value{}
+				for attribute, typ := range objectType.AttributeTypes {
+					values[attribute] = tftypes.NewValue(typ, nil)
+				}
+
+				values["asteroid_name"] = tftypes.NewValue(tftypes.String, "isabell")
+				values["maildomain_name"] = tftypes.NewValue(tftypes.String, "isabell.example")
+				values["name"] = tftypes.NewValue(tftypes.String, name)
+				values["local"] = tftypes.NewValue(tftypes.String, name)
+
+				for attribute, v := range attributes {
+					values[attribute] = v
+				}
+
+				return tftypes.NewValue(objectType, values)
+			}
+
+			req := fwresource.UpdateRequest{
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: value("info", tt.plan)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value("team", tt.plan)},
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value("team", tt.config)},
+			}
+
+			resp := fwresource.UpdateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: value("info", tt.plan)},
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: identityResp.IdentitySchema,
+					Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			r.Update(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			if deleted != "info@isabell.example" {
+				t.Errorf("deleted %q, want info@isabell.example", deleted)
+			}
+
+			if got := created.PasswordHash.Or(""); created.Name != "team" || got != hash {
+				t.Errorf("created %s with password hash %q, want team with %q", created.Name, got, hash)
+			}
+		})
+	}
+}
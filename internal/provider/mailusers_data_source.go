@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MailusersDataSource{}
+
+func NewMailusersDataSource() datasource.DataSource {
+	return &MailusersDataSource{}
+}
+
+// MailusersDataSource defines the data source implementation. Password
+// hashes returned by the API are never exposed.
+type MailusersDataSource struct {
+	client *client.Client
+}
+
+type mailusersDataSourceModel struct {
+	Asteroid   types.String        `tfsdk:"asteroid"`
+	Maildomain types.String        `tfsdk:"maildomain"`
+	Mailusers  []mailuserDataModel `tfsdk:"mailusers"`
+}
+
+type mailuserDataModel struct {
+	Pk           types.String           `tfsdk:"pk"`
+	Asteroid     types.String           `tfsdk:"asteroid"`
+	Name         types.String           `tfsdk:"name"`
+	Mailaddr     types.String           `tfsdk:"mailaddr"`
+	IsCatchall   types.Bool             `tfsdk:"is_catchall"`
+	IsSysmail    types.Bool             `tfsdk:"is_sysmail"`
+	KeepForwards types.Bool             `tfsdk:"keep_forwards"`
+	Forwards     []mailForwardDataModel `tfsdk:"forwards"`
+	CreatedAt    types.String           `tfsdk:"created_at"`
+	UpdatedAt    types.String           `tfsdk:"updated_at"`
+}
+
+type mailForwardDataModel struct {
+	Destination types.String `tfsdk:"destination"`
+	Keep        types.Bool   `tfsdk:"keep"`
+}
+
+func (d *MailusersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mailusers"
+}
+
+func (d *MailusersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "All mail users of an asteroid or one of its mail domains. Password hashes are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"asteroid": schema.StringAttribute{
+				Description: "Name of a hosting account, e.g. 'isabell'.",
+				Required:    true,
+			},
+			"maildomain": schema.StringAttribute{
+				Description: "Only return mail users of this mail domain.",
+				Optional:    true,
+			},
+			"mailusers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pk": schema.StringAttribute{
+							Computed: true,
+						},
+						"asteroid": schema.StringAttribute{
+							Description: "Name of a hosting account, e.g. 'isabell'.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Local part of the mail address, e.g. 'isabell' for 'isabell@example.org'.",
+							Computed:    true,
+						},
+						"mailaddr": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_catchall": schema.BoolAttribute{
+							Description: "Whether this mail user receives all mails to the domain.",
+							Computed:    true,
+						},
+						"is_sysmail": schema.BoolAttribute{
+							Description: "Whether this mail user receives mails to name@uber.space.",
+							Computed:    true,
+						},
+						"keep_forwards": schema.BoolAttribute{
+							Description: "If the mails stay in the mailbox after forwarding (true), or are deleted (false).",
+							Computed:    true,
+						},
+						"forwards": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"destination": schema.StringAttribute{
+										Description: "Mail address to forward to, e.g. 'isabell@example.org'.",
+										Computed:    true,
+									},
+									"keep": schema.BoolAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MailusersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *MailusersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mailusersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mailusers, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.MailUser], error) {
+		if !data.Maildomain.IsNull() {
+			return d.client.AsteroidsMaildomainsUsersList(ctx, client.AsteroidsMaildomainsUsersListParams{
+				AsteroidName:   data.Asteroid.ValueString(),
+				MaildomainName: data.Maildomain.ValueString(),
+				Limit:          client.NewOptInt(limit),
+				Offset:         client.NewOptInt(offset),
+			})
+		}
+
+		return d.client.AsteroidsMailusersList(ctx, client.AsteroidsMailusersListParams{
+			AsteroidName: data.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read mail users", err)
+		return
+	}
+
+	data.Mailusers = []mailuserDataModel{}
+
+	for _, mailuser := range mailusers {
+		model := mailuserDataModel{
+			Pk:           types.StringValue(mailuser.Pk),
+			Asteroid:     types.StringValue(mailuser.Asteroid),
+			Name:         types.StringValue(mailuser.Name),
+			Mailaddr:     types.StringValue(mailuser.Mailaddr),
+			IsCatchall:   types.BoolValue(mailuser.IsCatchall.Or(false)),
+			IsSysmail:    types.BoolValue(mailuser.IsSysmail.Or(false)),
+			KeepForwards: types.BoolValue(mailuser.KeepForwards.Or(false)),
+			Forwards:     []mailForwardDataModel{},
+			CreatedAt:    types.StringValue(mailuser.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:    types.StringValue(mailuser.UpdatedAt.Format(time.RFC3339)),
+		}
+
+		for _, forward := range mailuser.Forwards {
+			model.Forwards = append(model.Forwards, mailForwardDataModel{
+				Destination: types.StringValue(forward.Destination),
+				Keep:        types.BoolValue(forward.Keep),
+			})
+		}
+
+		data.Mailusers = append(data.Mailusers, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMailusersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailusersDataSourceConfig("terra", "userlist.terra.uber.space", "test"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uberspace_mailusers.test",
+						tfjsonpath.New("mailusers"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"mailaddr": knownvalue.StringExact("test@userlist.terra.uber.space"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMailusersDataSourceConfig(asteroid, maildomain, username string) string {
+	return fmt.Sprintf(`
+resource "uberspace_maildomain" "test" {
+  asteroid = %[1]q
+  name     = %[2]q
+}
+
+resource "uberspace_mailuser" "test" {
+  depends_on = [uberspace_maildomain.test]
+
+  asteroid_name   = %[1]q
+  maildomain_name = uberspace_maildomain.test.name
+  name            = %[3]q
+  password_hash   = "xxx"
+}
+
+data "uberspace_mailusers" "test" {
+  asteroid   = %[1]q
+  maildomain = uberspace_mailuser.test.maildomain_name
+}
+`, asteroid, maildomain, username)
+}
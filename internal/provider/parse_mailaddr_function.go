@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseMailaddrFunction{}
+
+func NewParseMailaddrFunction() function.Function {
+	return &ParseMailaddrFunction{}
+}
+
+// ParseMailaddrFunction splits a mail address into its local part and mail
+// domain.
+type ParseMailaddrFunction struct{}
+
+type mailaddrModel struct {
+	Local      types.String `tfsdk:"local"`
+	Maildomain types.String `tfsdk:"maildomain"`
+}
+
+func (f *ParseMailaddrFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_mailaddr"
+}
+
+func (f *ParseMailaddrFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a mail address",
+		Description: "Splits a mail address like `info@isabell.example` into an object with the attributes `local` and `maildomain`, e.g. for the `name` and `maildomain_name` of `uberspace_mailuser`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "mailaddr",
+				Description: "Mail address to split.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"local":      types.StringType,
+				"maildomain": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseMailaddrFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mailaddr string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &mailaddr))
+	if resp.Error != nil {
+		return
+	}
+
+	local, domain, err := parseMailaddr(mailaddr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, mailaddrModel{
+		Local:      types.StringValue(local),
+		Maildomain: types.StringValue(domain),
+	}))
+}
+
+// parseMailaddr splits a mail address into its local part and domain.
+func parseMailaddr(mailaddr string) (local, domain string, err error) {
+	local, domain, ok := strings.Cut(mailaddr, "@")
+	if !ok || local == "" || domain == "" || strings.Contains(domain, "@") {
+		return "", "", errors.New("expected a mail address of the form local@domain")
+	}
+
+	return local, domain, nil
+}
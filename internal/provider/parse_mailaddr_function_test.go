@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseMailaddr(t *testing.T) {
+	tests := []struct {
+		mailaddr   string
+		wantLocal  string
+		wantDomain string
+		wantErr    bool
+	}{
+		{mailaddr: "info@isabell.example", wantLocal: "info", wantDomain: "isabell.example"},
+		{mailaddr: "first.last+tag@mail.isabell.example", wantLocal: "first.last+tag", wantDomain: "mail.isabell.example"},
+		{mailaddr: "isabell.example", wantErr: true},
+		{mailaddr: "@isabell.example", wantErr: true},
+		{mailaddr: "info@", wantErr: true},
+		{mailaddr: "info@isabell@example", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		local, domain, err := parseMailaddr(tt.mailaddr)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("parseMailaddr(%q): got error %v, want error %t", tt.mailaddr, err, tt.wantErr)
+		}
+
+		if local != tt.wantLocal || domain != tt.wantDomain {
+			t.Errorf("parseMailaddr(%q) = %q, %q, want %q, %q", tt.mailaddr, local, domain, tt.wantLocal, tt.wantDomain)
+		}
+	}
+}
+
+func TestAccParseMailaddrFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::uberspace::parse_mailaddr("info@isabell.example")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"local":      knownvalue.StringExact("info"),
+							"maildomain": knownvalue.StringExact("isabell.example"),
+						}),
+					),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::uberspace::parse_mailaddr("isabell.example")
+}
+`,
+				ExpectError: regexp.MustCompile(`local@domain`),
+			},
+		},
+	})
+}
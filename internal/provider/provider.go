@@ -1,21 +1,39 @@
 package provider
 
 import (
-	"cmp"
 	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
 )
 
 // Ensure UberspaceProvider satisfies various provider interfaces.
-var _ provider.Provider = &UberspaceProvider{}
+var (
+	_ provider.Provider                       = &UberspaceProvider{}
+	_ provider.ProviderWithListResources      = &UberspaceProvider{}
+	_ provider.ProviderWithFunctions          = &UberspaceProvider{}
+	_ provider.ProviderWithEphemeralResources = &UberspaceProvider{}
+	_ provider.ProviderWithActions            = &UberspaceProvider{}
+)
+
+// DefaultServerURL is the URL of the Uberspace API.
+const DefaultServerURL = "https://marvin.uberspace.is"
 
 // UberspaceProvider defines the provider implementation.
 type UberspaceProvider struct {
@@ -23,11 +41,41 @@ type UberspaceProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// serverURL is the URL of the Uberspace API, tests point it to a local
+	// server.
+	serverURL string
 }
 
 // UberspaceProviderModel describes the provider data model.
 type UberspaceProviderModel struct {
-	APIKey types.String `tfsdk:"apikey"`
+	APIKey          types.String `tfsdk:"apikey"`
+	APIKeyFile      types.String `tfsdk:"apikey_file"`
+	APIKeyCommand   types.String `tfsdk:"apikey_command"`
+	AsteroidAPIKeys types.Map    `tfsdk:"asteroid_apikeys"`
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+	ProxyURL        types.String `tfsdk:"proxy_url"`
+	CACertFile      types.String `tfsdk:"ca_cert_file"`
+	ClientCertFile  types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile   types.String `tfsdk:"client_key_file"`
+
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	CacheReads            types.Bool  `tfsdk:"cache_reads"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+	WaitForEvents             types.Bool `tfsdk:"wait_for_events"`
+}
+
+// ProviderData is handed to resources and data sources during Configure.
+type ProviderData struct {
+	Client *client.Client
+	Cache  *ReadCache
+	Events *EventWaiter
+
+	// AdoptExisting makes resources take ownership of already existing
+	// objects instead of failing on create.
+	AdoptExisting bool
 }
 
 func (p *UberspaceProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -42,6 +90,75 @@ func (p *UberspaceProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: "The API key for the Uberspace API. If not set, the environment variable UBERSPACE_APIKEY will be used.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("apikey_file"), path.MatchRoot("apikey_command")),
+				},
+			},
+			"apikey_file": schema.StringAttribute{
+				Description: "Path to a file containing the API key for the Uberspace API.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("apikey_command")),
+				},
+			},
+			"apikey_command": schema.StringAttribute{
+				Description: "Command printing the API key for the Uberspace API, e.g. `pass show uberspace/isabell`. It is run by the shell during provider configuration and the first line of its output is used.",
+				Optional:    true,
+			},
+			"asteroid_apikeys": schema.MapAttribute{
+				Description: "API keys for asteroids belonging to other Uberspace accounts, keyed by asteroid name or by a pattern like `team-*`. Requests to asteroids not matching any entry use the default API key.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Adopt already existing web domains, mail domains and web backends on create instead of failing. Existing web backends must match their configuration. Useful when migrating existing asteroids without import blocks.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout for a single request to the Uberspace API as a duration, e.g. `30s` or `2m`. Defaults to `60s`.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used to reach the Uberspace API. If not set, the HTTPS_PROXY environment variable will be used.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded CA bundle that is trusted in addition to the system certificate pool.",
+				Optional:    true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded TLS client certificate.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded private key of the TLS client certificate.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of concurrent modifying requests per asteroid. Marvin processes changes per asteroid one after another, so the default of `1` serializes them.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cache_reads": schema.BoolAttribute{
+				Description: "Read web backends, web headers and mail users from their list endpoints once per asteroid or mail domain instead of one request per resource. Speeds up plans with many of these resources.",
+				Optional:    true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip checking the configured API keys against the Uberspace API during provider configuration.",
+				Optional:    true,
+			},
+			"wait_for_events": schema.BoolAttribute{
+				Description: "Wait until Marvin has processed the events of a change before finishing it. Changes whose events fail are reported with the event logs.",
+				Optional:    true,
 			},
 		},
 	}
@@ -56,8 +173,32 @@ func (p *UberspaceProvider) ValidateConfig(ctx context.Context, req provider.Val
 		return
 	}
 
-	if !data.APIKey.IsUnknown() && data.APIKey.ValueString() == "" && os.Getenv("UBERSPACE_APIKEY") == "" {
-		resp.Diagnostics.AddError("Invalid configuration", "apikey or UBERSPACE_APIKEY must be set")
+	if !data.APIKey.IsUnknown() && data.APIKey.ValueString() == "" &&
+		data.APIKeyFile.IsNull() && data.APIKeyCommand.IsNull() &&
+		data.AsteroidAPIKeys.IsNull() && os.Getenv("UBERSPACE_APIKEY") == "" {
+		resp.Diagnostics.AddError("Invalid configuration", "apikey, apikey_file, apikey_command, asteroid_apikeys or UBERSPACE_APIKEY must be set")
+	}
+
+	if !data.AsteroidAPIKeys.IsUnknown() && !data.AsteroidAPIKeys.IsNull() {
+		for pattern := range data.AsteroidAPIKeys.Elements() {
+			if err := checkAsteroidPattern(pattern); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("asteroid_apikeys").AtMapKey(pattern),
+					"Invalid configuration",
+					fmt.Sprintf("%q is not a valid asteroid pattern: %s", pattern, err),
+				)
+			}
+		}
+	}
+
+	if !data.RequestTimeout.IsUnknown() && !data.RequestTimeout.IsNull() {
+		if _, err := time.ParseDuration(data.RequestTimeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid configuration",
+				"request_timeout must be a valid duration: "+err.Error(),
+			)
+		}
 	}
 }
 
@@ -70,18 +211,102 @@ func (p *UberspaceProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	apikey := cmp.Or(data.APIKey.ValueString(), os.Getenv("UBERSPACE_APIKEY"))
+	apikey := data.APIKey.ValueString()
+
+	// attribute the default API key was read from, empty for the environment
+	apikeyPath := path.Root("apikey")
+
+	switch {
+	case apikey != "":
+	case !data.APIKeyFile.IsNull():
+		var err error
+
+		apikeyPath = path.Root("apikey_file")
+
+		apikey, err = readAPIKeyFile(data.APIKeyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("apikey_file"), "Invalid configuration", err.Error())
+			return
+		}
+	case !data.APIKeyCommand.IsNull():
+		var err error
+
+		apikeyPath = path.Root("apikey_command")
+
+		apikey, err = runAPIKeyCommand(ctx, data.APIKeyCommand.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("apikey_command"), "Invalid configuration", err.Error())
+			return
+		}
+	default:
+		apikey = os.Getenv("UBERSPACE_APIKEY")
+		apikeyPath = path.Empty()
+	}
+
+	asteroidAPIKeys := map[string]string{}
+
+	resp.Diagnostics.Append(data.AsteroidAPIKeys.ElementsAs(ctx, &asteroidAPIKeys, false)...)
 
-	if apikey == "" {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if apikey == "" && len(asteroidAPIKeys) == 0 {
 		resp.Diagnostics.AddError(
 			"Invalid configuration",
-			"apikey or UBERSPACE_APIKEY must be set",
+			"apikey, apikey_file, apikey_command, asteroid_apikeys or UBERSPACE_APIKEY must be set",
+		)
+
+		return
+	}
+
+	var timeout time.Duration
+
+	if !data.RequestTimeout.IsNull() {
+		var err error
+
+		timeout, err = time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid configuration",
+				"request_timeout must be a valid duration: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	httpClient, err := NewHTTPClient(HTTPClientConfig{
+		Timeout:        timeout,
+		ProxyURL:       data.ProxyURL.ValueString(),
+		CACertFile:     data.CACertFile.ValueString(),
+		ClientCertFile: data.ClientCertFile.ValueString(),
+		ClientKeyFile:  data.ClientKeyFile.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create HTTP client",
+			"An error occurred while creating the HTTP client: "+err.Error(),
 		)
 
 		return
 	}
 
-	client, err := client.NewClient("https://marvin.uberspace.is", client.WithClient(NewAuthClient(apikey)))
+	maxConcurrentRequests := DefaultMaxConcurrentRequests
+	if !data.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(data.MaxConcurrentRequests.ValueInt64())
+	}
+
+	apikeys := APIKeys{Default: apikey, Asteroids: asteroidAPIKeys}
+	authClient := NewAuthClient(apikeys, UserAgent(p.version, req.TerraformVersion), httpClient)
+
+	cache := NewReadCache(data.CacheReads.ValueBool())
+
+	client, err := client.NewClient(p.serverURL, client.WithClient(cacheInvalidator{
+		next:  NewConcurrencyLimiter(authClient, maxConcurrentRequests),
+		cache: cache,
+	}))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Uberspace client",
@@ -91,8 +316,25 @@ func (p *UberspaceProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if !data.SkipCredentialsValidation.ValueBool() {
+		resp.Diagnostics.Append(validateCredentials(ctx, client, apikeys, apikeyPath)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	providerData := &ProviderData{
+		Client:        client,
+		Cache:         cache,
+		Events:        NewEventWaiter(data.WaitForEvents.ValueBool()),
+		AdoptExisting: data.AdoptExisting.ValueBool(),
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.ListResourceData = providerData
+	resp.ActionData = providerData
 }
 
 func (p *UberspaceProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -107,13 +349,54 @@ func (p *UberspaceProvider) Resources(_ context.Context) []func() resource.Resou
 }
 
 func (p *UberspaceProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewEventsDataSource,
+		NewWebdomainDataSource,
+		NewWebdomainsDataSource,
+		NewWebbackendsDataSource,
+		NewWebheadersDataSource,
+		NewMaildomainsDataSource,
+		NewMailusersDataSource,
+	}
+}
+
+func (p *UberspaceProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRefreshDNSAction,
+		NewRefreshAction,
+	}
+}
+
+func (p *UberspaceProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewMailPasswordEphemeralResource,
+	}
+}
+
+func (p *UberspaceProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewWebdomainListResource,
+		NewSshkeyListResource,
+		NewWebdomainBackendListResource,
+		NewWebdomainHeaderListResource,
+		NewMaildomainListResource,
+		NewMailuserListResource,
+	}
+}
+
+func (p *UberspaceProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewMailPasswordHashFunction,
+		NewParseMailaddrFunction,
+		NewSSHFingerprintFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &UberspaceProvider{
-			version: version,
+			version:   version,
+			serverURL: DefaultServerURL,
 		}
 	}
 }
@@ -4,8 +4,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -23,3 +26,46 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("UBERSPACE_APIKEY must be set for acceptance tests")
 	}
 }
+
+// testProviderConfigure configures the provider against a local API server
+// and returns the data it hands to resources, data sources, list resources
+// and actions.
+func testProviderConfigure(t *testing.T, serverURL string) *provider.ConfigureResponse {
+	t.Helper()
+
+	ctx := t.Context()
+
+	p := &UberspaceProvider{version: "test", serverURL: serverURL}
+
+	var schemaResp provider.SchemaResponse
+
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	values["apikey"] = tftypes.NewValue(tftypes.String, "secret")
+	values["skip_credentials_validation"] = tftypes.NewValue(tftypes.Bool, true)
+
+	req := provider.ConfigureRequest{
+		TerraformVersion: "1.14.0",
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(typ, values),
+		},
+	}
+
+	var resp provider.ConfigureResponse
+
+	p.Configure(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	return &resp
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &RefreshAction{}
+	_ action.ActionWithConfigure = &RefreshAction{}
+)
+
+// refreshClients are the Marvin clients on a host that process refresh events.
+var refreshClients = []string{"web", "mail", "dns"}
+
+func NewRefreshAction() action.Action {
+	return &RefreshAction{}
+}
+
+// RefreshAction asks Marvin to refresh all objects of a model on the host of
+// an asteroid.
+type RefreshAction struct {
+	client *client.Client
+}
+
+type refreshActionModel struct {
+	Asteroid    types.String `tfsdk:"asteroid"`
+	Model       types.String `tfsdk:"model"`
+	Client      types.String `tfsdk:"client"`
+	JobPriority types.Int64  `tfsdk:"job_priority"`
+}
+
+func (a *RefreshAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refresh"
+}
+
+func (a *RefreshAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a refresh event, which makes Marvin re-process all objects of a model on the host of an asteroid, including those of other asteroids on the host. Requires an API key that is allowed to refresh.",
+		Attributes: map[string]schema.Attribute{
+			"asteroid": schema.StringAttribute{
+				Description: "Name of a hosting account, e.g. 'isabell'. The event targets the host of this asteroid.",
+				Required:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "Model to refresh, e.g. `WebDomain` or `MailDomain`.",
+				Required:    true,
+			},
+			"client": schema.StringAttribute{
+				Description: "Marvin client on the host that processes the refresh, one of `web`, `mail` and `dns`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(refreshClients...),
+				},
+			},
+			"job_priority": schema.Int64Attribute{
+				Description: "Priority of the created jobs. Defaults to `0`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (a *RefreshAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = data.Client
+}
+
+func (a *RefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data refreshActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := client.RefreshEventRequest{
+		Model:  data.Model.ValueString(),
+		Client: data.Client.ValueString(),
+	}
+
+	if !data.JobPriority.IsNull() {
+		request.JobPriority = client.NewOptInt(int(data.JobPriority.ValueInt64()))
+	}
+
+	host, err := refresh(ctx, a.client, data.Asteroid.ValueString(), request)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to refresh", err)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested refresh of %s on %s", request.Model, host),
+	})
+}
+
+// refresh creates a refresh event on the host of the asteroid and returns the
+// host.
+func refresh(ctx context.Context, c *client.Client, asteroid string, request client.RefreshEventRequest) (string, error) {
+	ctx = withAsteroid(ctx, asteroid)
+
+	a, err := c.AsteroidsGet(ctx, client.AsteroidsGetParams{Name: asteroid})
+	if err != nil {
+		return "", err
+	}
+
+	request.TargetHost = a.Host
+
+	apiReq := client.RefresheventsCreateApplicationJSON(request)
+
+	if _, err := c.RefresheventsCreate(ctx, &apiReq, client.RefresheventsCreateParams{}); err != nil {
+		return "", err
+	}
+
+	return a.Host, nil
+}
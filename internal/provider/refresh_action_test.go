@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+func TestRefreshAction(t *testing.T) {
+	ctx := t.Context()
+
+	var got []client.RefreshEventRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/external/asteroids/{name}/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"pk":"1","name":%q,"host":"tuttle","active":true,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`, r.PathValue("name"))
+	})
+	mux.HandleFunc("POST /api/v1/common/refreshevents/", func(w http.ResponseWriter, r *http.Request) {
+		var request client.RefreshEventRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+
+		got = append(got, request)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"model":%q,"target_host":%q,"client":%q,"job_priority":%d}`, request.Model, request.TargetHost, request.Client, request.JobPriority.Or(0))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	a := &RefreshAction{}
+
+	var configureResp action.ConfigureResponse
+
+	a.Configure(ctx, action.ConfigureRequest{ProviderData: testProviderConfigure(t, server.URL).ActionData}, &configureResp)
+
+	if configureResp.Diagnostics.HasError() {
+		t.Fatal(configureResp.Diagnostics)
+	}
+
+	var schemaResp action.SchemaResponse
+
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"asteroid":     tftypes.NewValue(tftypes.String, "isabell"),
+				"model":        tftypes.NewValue(tftypes.String, "WebDomain"),
+				"client":       tftypes.NewValue(tftypes.String, "web"),
+				"job_priority": tftypes.NewValue(tftypes.Number, 5),
+			}),
+		},
+	}
+
+	var progress []string
+
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+
+	a.Invoke(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	want := client.RefreshEventRequest{Model: "WebDomain", TargetHost: "tuttle", Client: "web", JobPriority: client.NewOptInt(5)}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("got refresh events %+v, want %+v", got, want)
+	}
+
+	if len(progress) == 0 || !strings.Contains(progress[0], "tuttle") {
+		t.Errorf("got progress %v, want a message naming the host", progress)
+	}
+}
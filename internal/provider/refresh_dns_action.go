@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action                     = &RefreshDNSAction{}
+	_ action.ActionWithConfigure        = &RefreshDNSAction{}
+	_ action.ActionWithConfigValidators = &RefreshDNSAction{}
+)
+
+// DefaultRefreshDNSClient is the Marvin client that checks DNS records.
+const DefaultRefreshDNSClient = "dns"
+
+func NewRefreshDNSAction() action.Action {
+	return &RefreshDNSAction{
+		pollInterval: eventPollInterval,
+		timeout:      DefaultReadTimeout,
+	}
+}
+
+// RefreshDNSAction makes Marvin re-check the DNS records of a web or mail
+// domain instead of waiting for the periodic check. Refresh events target a
+// whole host, so all domains of the model on the host are checked, the domain
+// only selects the model and which check to wait for.
+type RefreshDNSAction struct {
+	client       *client.Client
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+type refreshDNSActionModel struct {
+	Asteroid   types.String `tfsdk:"asteroid"`
+	Webdomain  types.String `tfsdk:"webdomain"`
+	Maildomain types.String `tfsdk:"maildomain"`
+	Client     types.String `tfsdk:"client"`
+	Wait       types.Bool   `tfsdk:"wait"`
+}
+
+// dnsCheck is the DNS state of a web or mail domain.
+type dnsCheck struct {
+	state     client.DnsStateEnum
+	lastCheck client.NilDateTime
+	err       client.NilString
+}
+
+func (a *RefreshDNSAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refresh_dns"
+}
+
+func (a *RefreshDNSAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Makes Marvin re-check the DNS records of a web or mail domain, e.g. after they were changed at the DNS provider. The refresh event targets the host of the asteroid, so Marvin re-checks all web or mail domains on the host, including those of other asteroids, and the action waits for the check of the given domain. Requires an API key that is allowed to refresh.",
+		Attributes: map[string]schema.Attribute{
+			"asteroid": schema.StringAttribute{
+				Description: "Name of a hosting account, e.g. 'isabell'.",
+				Required:    true,
+			},
+			"webdomain": schema.StringAttribute{
+				Description: "Web domain to wait for. All web domains on the host are checked. Exactly one of `webdomain` and `maildomain` must be set.",
+				Optional:    true,
+			},
+			"maildomain": schema.StringAttribute{
+				Description: "Mail domain to wait for. All mail domains on the host are checked. Exactly one of `webdomain` and `maildomain` must be set.",
+				Optional:    true,
+			},
+			"client": schema.StringAttribute{
+				Description: fmt.Sprintf("Marvin client on the host that processes the refresh, one of `web`, `mail` and `dns`. Defaults to `%s`.", DefaultRefreshDNSClient),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(refreshClients...),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				Description: fmt.Sprintf("Wait up to %s until the domain was checked and fail if its DNS state is not `VALID`. Defaults to `true`.", DefaultReadTimeout),
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *RefreshDNSAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("webdomain"),
+			path.MatchRoot("maildomain"),
+		),
+	}
+}
+
+func (a *RefreshDNSAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = data.Client
+}
+
+func (a *RefreshDNSAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data refreshDNSActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asteroid := data.Asteroid.ValueString()
+	ctx = withAsteroid(ctx, asteroid)
+
+	model, domain := "WebDomain", data.Webdomain.ValueString()
+	if !data.Maildomain.IsNull() {
+		model, domain = "MailDomain", data.Maildomain.ValueString()
+	}
+
+	before, err := a.check(ctx, asteroid, model, domain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read domain", err)
+		return
+	}
+
+	refreshClient := DefaultRefreshDNSClient
+	if !data.Client.IsNull() {
+		refreshClient = data.Client.ValueString()
+	}
+
+	host, err := refresh(ctx, a.client, asteroid, client.RefreshEventRequest{
+		Model:  model,
+		Client: refreshClient,
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to refresh DNS", err)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested DNS check of all %s objects on %s", model, host),
+	})
+
+	if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+		return
+	}
+
+	after, err := a.waitForCheck(ctx, asteroid, model, domain, before)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to refresh DNS", err)
+		return
+	}
+
+	if after.state != client.DnsStateEnumVALID {
+		resp.Diagnostics.AddError(
+			"Invalid DNS Records",
+			fmt.Sprintf("The DNS state of %s is %s: %s", domain, after.state, after.err.Or("no error message")),
+		)
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("DNS records of %s are valid", domain),
+	})
+}
+
+// waitForCheck polls the domain until its last DNS check differs from before.
+func (a *RefreshDNSAction) waitForCheck(ctx context.Context, asteroid, model, domain string, before dnsCheck) (dnsCheck, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	timeoutErr := func(err error) error {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s was not checked within %s", domain, a.timeout)
+		}
+
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return dnsCheck{}, timeoutErr(ctx.Err())
+		case <-time.After(a.pollInterval):
+		}
+
+		after, err := a.check(ctx, asteroid, model, domain)
+		if err != nil {
+			// the deadline may pass while a request is in flight
+			return dnsCheck{}, timeoutErr(err)
+		}
+
+		if !after.lastCheck.Null && (before.lastCheck.Null || after.lastCheck.Value.After(before.lastCheck.Value)) {
+			return after, nil
+		}
+	}
+}
+
+func (a *RefreshDNSAction) check(ctx context.Context, asteroid, model, domain string) (dnsCheck, error) {
+	if model == "MailDomain" {
+		m, err := a.client.AsteroidsMaildomainsGet(ctx, client.AsteroidsMaildomainsGetParams{
+			AsteroidName: asteroid,
+			Name:         domain,
+		})
+		if err != nil {
+			return dnsCheck{}, err
+		}
+
+		return dnsCheck{state: m.DNSState, lastCheck: m.DNSLastCheck, err: m.DNSError}, nil
+	}
+
+	w, err := a.client.AsteroidsWebdomainsGet(ctx, client.AsteroidsWebdomainsGetParams{
+		AsteroidName: asteroid,
+		Name:         domain,
+	})
+	if err != nil {
+		return dnsCheck{}, err
+	}
+
+	return dnsCheck{state: w.DNSState, lastCheck: w.DNSLastCheck, err: w.DNSError}, nil
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+func TestRefreshDNSAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   string
+		client  string
+		checked bool
+		wait    bool
+		wantErr string
+	}{
+		{name: "valid", state: "VALID", checked: true, wait: true},
+		{name: "client", state: "VALID", client: "web", checked: true, wait: true},
+		{name: "invalid", state: "INVALID", checked: true, wait: true, wantErr: "no TXT record"},
+		{name: "not checked", state: "VALID", wait: true, wantErr: "was not checked"},
+		{name: "no wait", state: "VALID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			wantClient, configClient := DefaultRefreshDNSClient, tftypes.NewValue(tftypes.String, nil)
+			if tt.client != "" {
+				wantClient, configClient = tt.client, tftypes.NewValue(tftypes.String, tt.client)
+			}
+
+			var refreshed atomic.Int64
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v1/external/asteroids/{name}/", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"pk":"1","name":%q,"host":"tuttle","active":true,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`, r.PathValue("name"))
+			})
+			mux.HandleFunc("POST /api/v1/common/refreshevents/", func(w http.ResponseWriter, r *http.Request) {
+				var request client.RefreshEventRequest
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Error(err)
+				}
+
+				if request.Model != "WebDomain" || request.TargetHost != "tuttle" || request.Client != wantClient {
+					t.Errorf("got refresh event %+v", request)
+				}
+
+				refreshed.Add(1)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintf(w, `{"model":%q,"target_host":%q,"client":%q,"job_priority":0}`, request.Model, request.TargetHost, request.Client)
+			})
+			mux.HandleFunc("GET /api/v1/external/asteroids/{asteroid}/webdomains/{name}/", func(w http.ResponseWriter, r *http.Request) {
+				lastCheck, dnsError := `"2025-01-01T00:00:00Z"`, "null"
+				if tt.checked && refreshed.Load() > 0 {
+					lastCheck = `"2025-01-02T00:00:00Z"`
+
+					if tt.state != "VALID" {
+						dnsError = `"no TXT record"`
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"name":%[1]q,"name_display":%[1]q,"name_idn":%[1]q,"dns_validation_token":"token","dns_state":%[2]q,"dns_last_check":%[3]s,"dns_error":%[4]s,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","asteroid":"isabell"}`,
+					r.PathValue("name"), tt.state, lastCheck, dnsError)
+			})
+
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			a := &RefreshDNSAction{pollInterval: time.Millisecond, timeout: 50 * time.Millisecond}
+
+			var configureResp action.ConfigureResponse
+
+			a.Configure(ctx, action.ConfigureRequest{ProviderData: testProviderConfigure(t, server.URL).ActionData}, &configureResp)
+
+			if configureResp.Diagnostics.HasError() {
+				t.Fatal(configureResp.Diagnostics)
+			}
+
+			var schemaResp action.SchemaResponse
+
+			a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+			req := action.InvokeRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+						"asteroid":   tftypes.NewValue(tftypes.String, "isabell"),
+						"webdomain":  tftypes.NewValue(tftypes.String, "isabell.example"),
+						"maildomain": tftypes.NewValue(tftypes.String, nil),
+						"client":     configClient,
+						"wait":       tftypes.NewValue(tftypes.Bool, tt.wait),
+					}),
+				},
+			}
+
+			var progress []string
+
+			resp := action.InvokeResponse{
+				SendProgress: func(event action.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+
+			a.Invoke(ctx, req, &resp)
+
+			if got := refreshed.Load(); got != 1 {
+				t.Errorf("got %d refresh events, want 1", got)
+			}
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("got diagnostics %v, want error containing %q", resp.Diagnostics, tt.wantErr)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			if len(progress) == 0 || !strings.Contains(progress[0], "tuttle") {
+				t.Errorf("got progress %v, want a message naming the host", progress)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/crypto/ssh"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SSHFingerprintFunction{}
+
+func NewSSHFingerprintFunction() function.Function {
+	return &SSHFingerprintFunction{}
+}
+
+// SSHFingerprintFunction returns the fingerprint of a public SSH key.
+type SSHFingerprintFunction struct{}
+
+func (f *SSHFingerprintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ssh_fingerprint"
+}
+
+func (f *SSHFingerprintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Fingerprint of a public SSH key",
+		Description: "Returns the SHA256 fingerprint of a public SSH key in `authorized_keys` format, as printed by `ssh-keygen -l`, e.g. `SHA256:...`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "pubkey",
+				Description: "Public SSH key, e.g. `ssh-ed25519 AAAA... comment`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SSHFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pubkey string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pubkey))
+	if resp.Error != nil {
+		return
+	}
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(pubkey))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid public SSH key: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ssh.FingerprintSHA256(key)))
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSSHFingerprintFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::uberspace::ssh_fingerprint("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMC2joC/g9BjJAUIf81SBBxIu9FwGLOCsL/QEO6d5im isabell@example")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("SHA256:+L4SsP9tRUioTl9rG5lUpwXMgx1cceXW1Bi1BstnnUc"),
+					),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::uberspace::ssh_fingerprint("not a key")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid public SSH key`),
+			},
+		},
+	})
+}
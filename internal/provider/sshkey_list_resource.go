@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &SshkeyListResource{}
+	_ list.ListResourceWithConfigure = &SshkeyListResource{}
+)
+
+func NewSshkeyListResource() list.ListResource {
+	return &SshkeyListResource{}
+}
+
+// SshkeyListResource lists the SSH keys of an asteroid.
+type SshkeyListResource struct {
+	client *client.Client
+}
+
+func (r *SshkeyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sshkey"
+}
+
+func (r *SshkeyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("Lists the SSH keys of an asteroid.")
+}
+
+func (r *SshkeyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = listClient(req, resp)
+}
+
+func (r *SshkeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listConfigModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	fetch := func(ctx context.Context, limit, offset int) (pagination.Page[client.SshKey], error) {
+		return r.client.AsteroidsSshkeysList(ctx, client.AsteroidsSshkeysListParams{
+			AsteroidName: config.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	}
+
+	stream.Results = listResults(ctx, req, "Unable to read ssh keys", fetch, func(ctx context.Context, sshKey *client.SshKey, result *list.ListResult) bool {
+		model := sshkeyModel{Timeouts: nullTimeouts()}
+		model.fromAPI(sshKey)
+
+		result.DisplayName = sshKey.KeyComment.Or(sshKey.ShortenedKey)
+		result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		}
+
+		return true
+	})
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
@@ -13,7 +16,53 @@ import (
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &SshkeyResource{}
+var (
+	_ resource.Resource                 = &SshkeyResource{}
+	_ resource.ResourceWithIdentity     = &SshkeyResource{}
+	_ resource.ResourceWithImportState  = &SshkeyResource{}
+	_ resource.ResourceWithUpgradeState = &SshkeyResource{}
+)
+
+// sshkeyModel extends the generated model with the timeouts block.
+type sshkeyModel struct {
+	resource_sshkey.SshkeyModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// sshkeyIdentityModel describes the resource identity.
+type sshkeyIdentityModel struct {
+	Asteroid types.String `tfsdk:"asteroid"`
+	ID       types.Int64  `tfsdk:"id"`
+}
+
+func (m *sshkeyModel) identity() sshkeyIdentityModel {
+	return sshkeyIdentityModel{
+		Asteroid: m.Asteroid,
+		ID:       m.Id,
+	}
+}
+
+// fromAPI sets the model from an SSH key returned by the API.
+func (m *sshkeyModel) fromAPI(sshKey *client.SshKey) {
+	m.Pk = types.Int64Value(int64(sshKey.Pk))
+	m.FormattedKey = types.StringValue(sshKey.FormattedKey)
+	m.ShortenedKey = types.StringValue(sshKey.ShortenedKey)
+	m.CreatedAt = types.StringValue(sshKey.CreatedAt.Format(time.RFC3339))
+	m.UpdatedAt = types.StringValue(sshKey.UpdatedAt.Format(time.RFC3339))
+
+	m.Key = types.StringValue(sshKey.Key)
+	if v, ok := sshKey.KeyComment.Get(); ok {
+		m.KeyComment = types.StringValue(v)
+	} else {
+		m.KeyComment = types.StringNull()
+	}
+
+	m.KeyType = types.StringValue(string(sshKey.KeyType))
+	m.Asteroid = types.StringValue(sshKey.Asteroid)
+	m.AsteroidName = types.StringValue(sshKey.Asteroid)
+	m.Id = types.Int64Value(int64(sshKey.Pk))
+	m.Format = types.StringValue("json")
+}
 
 // NewSshkeyResource returns a new resource instance.
 func NewSshkeyResource() resource.Resource {
@@ -23,6 +72,7 @@ func NewSshkeyResource() resource.Resource {
 // SshkeyResource defines the resource implementation.
 type SshkeyResource struct {
 	client *client.Client
+	events *EventWaiter
 }
 
 func (r *SshkeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -30,7 +80,23 @@ func (r *SshkeyResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 func (r *SshkeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_sshkey.SshkeyResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, resource_sshkey.SshkeyResourceSchema(ctx))
+	resp.Schema.Version = 1
+}
+
+func (r *SshkeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"asteroid": identityschema.StringAttribute{
+				Description:       "Name of the asteroid the SSH key belongs to.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.Int64Attribute{
+				Description:       "ID of the SSH key.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *SshkeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -38,21 +104,22 @@ func (r *SshkeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *uberspace.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
+	r.events = data.Events
 }
 
 func (r *SshkeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_sshkey.SshkeyModel
+	var plan sshkeyModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -60,6 +127,22 @@ func (r *SshkeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	reqBody := client.SshKeyRequest{
 		Asteroid: plan.Asteroid.ValueString(),
 		Key:      plan.Key.ValueString(),
@@ -75,32 +158,22 @@ func (r *SshkeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		AsteroidName: plan.Asteroid.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ssh key, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create ssh key", err)
 		return
 	}
 
-	plan.Pk = types.Int64Value(int64(sshKey.Pk))
-	plan.Id = types.Int64Value(int64(sshKey.Pk))
-	plan.FormattedKey = types.StringValue(sshKey.FormattedKey)
-	plan.ShortenedKey = types.StringValue(sshKey.ShortenedKey)
-	plan.CreatedAt = types.StringValue(sshKey.CreatedAt.Format(time.RFC3339))
-	plan.UpdatedAt = types.StringValue(sshKey.UpdatedAt.Format(time.RFC3339))
+	plan.fromAPI(sshKey)
 
-	plan.Key = types.StringValue(sshKey.Key)
-	if v, ok := sshKey.KeyComment.Get(); ok {
-		plan.KeyComment = types.StringValue(v)
+	if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeSSHKey, ID: sshKey.Pk}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to create ssh key", err)
 	}
 
-	plan.KeyType = types.StringValue(string(sshKey.KeyType))
-	plan.Asteroid = types.StringValue(sshKey.Asteroid)
-	plan.AsteroidName = types.StringValue(sshKey.Asteroid)
-	plan.Format = types.StringValue("json")
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *SshkeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_sshkey.SshkeyModel
+	var state sshkeyModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -108,39 +181,33 @@ func (r *SshkeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	sshKey, err := r.client.AsteroidsSshkeysGet(ctx, client.AsteroidsSshkeysGetParams{
 		AsteroidName: state.Asteroid.ValueString(),
 		ID:           int(state.Id.ValueInt64()),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ssh key, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read ssh key", err)
 		return
 	}
 
-	state.Pk = types.Int64Value(int64(sshKey.Pk))
-	state.FormattedKey = types.StringValue(sshKey.FormattedKey)
-	state.ShortenedKey = types.StringValue(sshKey.ShortenedKey)
-	state.CreatedAt = types.StringValue(sshKey.CreatedAt.Format(time.RFC3339))
-	state.UpdatedAt = types.StringValue(sshKey.UpdatedAt.Format(time.RFC3339))
-
-	state.Key = types.StringValue(sshKey.Key)
-	if v, ok := sshKey.KeyComment.Get(); ok {
-		state.KeyComment = types.StringValue(v)
-	} else {
-		state.KeyComment = types.StringNull()
-	}
-
-	state.KeyType = types.StringValue(string(sshKey.KeyType))
-	state.Asteroid = types.StringValue(sshKey.Asteroid)
-	state.AsteroidName = types.StringValue(sshKey.Asteroid)
-	state.Id = types.Int64Value(int64(sshKey.Pk))
-	state.Format = types.StringValue("json")
+	state.fromAPI(sshKey)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *SshkeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state, plan resource_sshkey.SshkeyModel
+	var state, plan sshkeyModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -149,11 +216,27 @@ func (r *SshkeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsSshkeysDelete(ctx, client.AsteroidsSshkeysDeleteParams{
 		AsteroidName: state.Asteroid.ValueString(),
 		ID:           int(state.Id.ValueInt64()),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ssh key, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete ssh key", err)
 		return
 	}
 
@@ -172,32 +255,22 @@ func (r *SshkeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		AsteroidName: plan.Asteroid.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ssh key, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create ssh key", err)
 		return
 	}
 
-	plan.Pk = types.Int64Value(int64(sshKey.Pk))
-	plan.Id = types.Int64Value(int64(sshKey.Pk))
-	plan.FormattedKey = types.StringValue(sshKey.FormattedKey)
-	plan.ShortenedKey = types.StringValue(sshKey.ShortenedKey)
-	plan.CreatedAt = types.StringValue(sshKey.CreatedAt.Format(time.RFC3339))
-	plan.UpdatedAt = types.StringValue(sshKey.UpdatedAt.Format(time.RFC3339))
+	plan.fromAPI(sshKey)
 
-	plan.Key = types.StringValue(sshKey.Key)
-	if v, ok := sshKey.KeyComment.Get(); ok {
-		plan.KeyComment = types.StringValue(v)
+	if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeSSHKey, ID: sshKey.Pk}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to update ssh key", err)
 	}
 
-	plan.KeyType = types.StringValue(string(sshKey.KeyType))
-	plan.Asteroid = types.StringValue(sshKey.Asteroid)
-	plan.AsteroidName = types.StringValue(sshKey.Asteroid)
-	plan.Format = types.StringValue("json")
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *SshkeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_sshkey.SshkeyModel
+	var state sshkeyModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -205,11 +278,61 @@ func (r *SshkeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, state.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsSshkeysDelete(ctx, client.AsteroidsSshkeysDeleteParams{
 		AsteroidName: state.Asteroid.ValueString(),
 		ID:           int(state.Id.ValueInt64()),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ssh key, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete ssh key", err)
 		return
 	}
+
+	if err := r.events.Wait(ctx, r.client, state.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeSSHKey, ID: int(state.Pk.ValueInt64())}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to delete ssh key", err)
+	}
+}
+
+func (r *SshkeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []importAttribute{
+		{Identity: "asteroid", State: "asteroid"},
+		{Identity: "id", State: "id", Int64: true},
+	})
+}
+
+func (r *SshkeyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeState(sshkeySchemaV0, nil),
+	}
+}
+
+// sshkeySchemaV0 are the attribute types of the unversioned SSH key schema.
+var sshkeySchemaV0 = map[string]attr.Type{
+	"asteroid":      types.StringType,
+	"asteroid_name": types.StringType,
+	"created_at":    types.StringType,
+	"format":        types.StringType,
+	"formatted_key": types.StringType,
+	"id":            types.Int64Type,
+	"key":           types.StringType,
+	"key_comment":   types.StringType,
+	"key_type":      types.StringType,
+	"pk":            types.Int64Type,
+	"shortened_key": types.StringType,
+	"updated_at":    types.StringType,
+	"timeouts":      timeoutsV0,
 }
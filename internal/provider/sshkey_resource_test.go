@@ -45,6 +45,12 @@ func TestAccSshkeyResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by identity testing
+			{
+				ResourceName:    "uberspace_sshkey.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{
 				Config: testAccSshkeyResourceConfig("terra", "ssh-ed25519", testAccSshkeyValueTwo, "terraform+updated@example.com"),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stateConversion rewrites the attribute values of a prior state in place,
+// e.g. to convert an attribute whose type changed. Attributes that are not in
+// the current schema anymore are dropped after the conversion.
+type stateConversion func(values map[string]tftypes.Value) error
+
+// upgradeState returns a state upgrader from a prior schema version. The
+// attribute types of every released schema version are frozen in the resource
+// files, so regenerating the schema cannot change how old states are read.
+//
+// Attributes are copied by name: attributes removed from the schema are
+// dropped and new attributes are null until the next read. Type changes need
+// a conversion, otherwise the upgrade fails. Terraform upgrades from each
+// prior version directly to the current one, so when the schema version is
+// bumped, every existing upgrader needs the conversions of the new version.
+func upgradeState(prior map[string]attr.Type, convert stateConversion) resource.StateUpgrader {
+	priorSchema := priorSchema(prior)
+
+	return resource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			values := map[string]tftypes.Value{}
+			if err := req.State.Raw.As(&values); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+				return
+			}
+
+			if convert != nil {
+				if err := convert(values); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+					return
+				}
+			}
+
+			target, ok := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The resource schema is not an object.")
+				return
+			}
+
+			upgraded := make(map[string]tftypes.Value, len(target.AttributeTypes))
+
+			for name, typ := range target.AttributeTypes {
+				value, ok := values[name]
+
+				switch {
+				case !ok:
+					value = tftypes.NewValue(typ, nil)
+				case !value.Type().Equal(typ):
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade Resource State",
+						fmt.Sprintf("Attribute %q changed its type from %s to %s without a conversion. Please report this issue to the provider developers.", name, value.Type(), typ),
+					)
+
+					return
+				}
+
+				upgraded[name] = value
+			}
+
+			resp.State.Raw = tftypes.NewValue(target, upgraded)
+		},
+	}
+}
+
+// priorSchema returns a schema that reads states with the given attribute
+// types. Only the types matter for reading a prior state, so every attribute
+// is optional and blocks like timeouts are object attributes.
+func priorSchema(attrTypes map[string]attr.Type) schema.Schema {
+	attributes := make(map[string]schema.Attribute, len(attrTypes))
+
+	for name, typ := range attrTypes {
+		switch t := typ.(type) {
+		case basetypes.StringType:
+			attributes[name] = schema.StringAttribute{Optional: true}
+		case basetypes.Int64Type:
+			attributes[name] = schema.Int64Attribute{Optional: true}
+		case basetypes.BoolType:
+			attributes[name] = schema.BoolAttribute{Optional: true}
+		case basetypes.ListType:
+			attributes[name] = schema.ListAttribute{ElementType: t.ElemType, Optional: true}
+		case basetypes.ObjectType:
+			attributes[name] = schema.ObjectAttribute{AttributeTypes: t.AttrTypes, Optional: true}
+		default:
+			panic(fmt.Sprintf("prior schema attribute %q has unsupported type %s", name, typ))
+		}
+	}
+
+	return schema.Schema{Attributes: attributes}
+}
+
+// timeoutsV0 is the type of the timeouts block in schema version 0.
+var timeoutsV0 = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}}
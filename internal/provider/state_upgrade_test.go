@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeState(t *testing.T) {
+	ctx := t.Context()
+
+	prior := map[string]attr.Type{
+		"id":     types.Int64Type,
+		"format": types.StringType,
+		"name":   types.StringType,
+	}
+
+	current := schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"name":    schema.StringAttribute{Required: true},
+			"comment": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	idToString := func(values map[string]tftypes.Value) error {
+		var id big.Float
+		if err := values["id"].As(&id); err != nil {
+			return err
+		}
+
+		values["id"] = tftypes.NewValue(tftypes.String, id.Text('f', 0))
+
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		convert stateConversion
+		want    map[string]tftypes.Value
+		wantErr string
+	}{
+		{
+			name:    "conversion",
+			convert: idToString,
+			want: map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, "42"),
+				"name":    tftypes.NewValue(tftypes.String, "isabell"),
+				"comment": tftypes.NewValue(tftypes.String, nil),
+			},
+		},
+		{
+			name:    "missing conversion",
+			wantErr: `Attribute "id" changed its type`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgrader := upgradeState(prior, tt.convert)
+
+			req := resource.UpgradeStateRequest{
+				State: &tfsdk.State{
+					Schema: *upgrader.PriorSchema,
+					Raw: tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.Number, 42),
+						"format": tftypes.NewValue(tftypes.String, "json"),
+						"name":   tftypes.NewValue(tftypes.String, "isabell"),
+					}),
+				},
+			}
+
+			resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: current}}
+
+			upgrader.StateUpgrader(ctx, req, &resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("got diagnostics %v, want error containing %q", resp.Diagnostics, tt.wantErr)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			want := tftypes.NewValue(current.Type().TerraformType(ctx), tt.want)
+			if !resp.State.Raw.Equal(want) {
+				t.Errorf("got state %s, want %s", resp.State.Raw, want)
+			}
+		})
+	}
+}
+
+// TestResourceStateUpgraders upgrades a state of every prior schema version
+// of every resource and checks that the values of kept attributes survive.
+func TestResourceStateUpgraders(t *testing.T) {
+	ctx := t.Context()
+
+	p := New("test")()
+
+	server, err := providerserver.NewProtocol6WithError(p)()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "uberspace"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			upgradable, ok := r.(resource.ResourceWithUpgradeState)
+			if !ok {
+				t.Fatal("resource does not implement state upgrades")
+			}
+
+			current := schemas.ResourceSchemas[metadata.TypeName]
+			upgraders := upgradable.UpgradeState(ctx)
+
+			// write-only values are never stored in state
+			writeOnly := map[string]bool{}
+			for _, a := range current.Block.Attributes {
+				writeOnly[a.Name] = a.WriteOnly
+			}
+
+			for version := range current.Version {
+				upgrader, ok := upgraders[version]
+				if !ok {
+					t.Fatalf("no state upgrader from version %d to %d", version, current.Version)
+				}
+
+				state := map[string]any{}
+				for name, typ := range upgrader.PriorSchema.Type().(types.ObjectType).AttrTypes {
+					state[name] = sampleStateValue(typ)
+				}
+
+				raw, err := json.Marshal(state)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+					TypeName: metadata.TypeName,
+					Version:  version,
+					RawState: &tfprotov6.RawState{JSON: raw},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for _, d := range resp.Diagnostics {
+					t.Fatalf("version %d: %s: %s", version, d.Summary, d.Detail)
+				}
+
+				upgraded, err := resp.UpgradedState.Unmarshal(current.ValueType())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				values := map[string]tftypes.Value{}
+				if err := upgraded.As(&values); err != nil {
+					t.Fatal(err)
+				}
+
+				for name, value := range values {
+					if _, ok := state[name]; !ok || writeOnly[name] {
+						if !value.IsNull() {
+							t.Errorf("version %d: new attribute %s = %s, want null", version, name, value)
+						}
+
+						continue
+					}
+
+					got, err := json.Marshal(stateValueJSON(t, value))
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					want, _ := json.Marshal(state[name])
+					if string(got) != string(want) {
+						t.Errorf("version %d: %s = %s, want %s", version, name, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// sampleStateValue returns a JSON state value of the type.
+func sampleStateValue(typ attr.Type) any {
+	switch t := typ.(type) {
+	case basetypes.StringType:
+		return "value"
+	case basetypes.Int64Type:
+		return 42
+	case basetypes.BoolType:
+		return true
+	case basetypes.ListType:
+		return []any{sampleStateValue(t.ElemType)}
+	case basetypes.ObjectType:
+		object := map[string]any{}
+		for name, attrType := range t.AttrTypes {
+			object[name] = sampleStateValue(attrType)
+		}
+
+		return object
+	default:
+		panic(fmt.Sprintf("unsupported type %s", typ))
+	}
+}
+
+// stateValueJSON converts a state value to the JSON it was read from.
+func stateValueJSON(t *testing.T, value tftypes.Value) any {
+	t.Helper()
+
+	if value.IsNull() {
+		return nil
+	}
+
+	typ := value.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+
+		return s
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		_ = value.As(&n)
+
+		i, _ := n.Int64()
+
+		return i
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+
+		return b
+	case typ.Is(tftypes.List{}):
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+
+		out := make([]any, 0, len(elements))
+		for _, e := range elements {
+			out = append(out, stateValueJSON(t, e))
+		}
+
+		return out
+	case typ.Is(tftypes.Object{}):
+		attributes := map[string]tftypes.Value{}
+		_ = value.As(&attributes)
+
+		out := map[string]any{}
+		for name, a := range attributes {
+			out[name] = stateValueJSON(t, a)
+		}
+
+		return out
+	default:
+		t.Fatalf("unsupported type %s", typ)
+		return nil
+	}
+}
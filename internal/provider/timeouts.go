@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default timeouts for resource operations without a timeouts block.
+const (
+	DefaultCreateTimeout = 10 * time.Minute
+	DefaultReadTimeout   = 5 * time.Minute
+	DefaultUpdateTimeout = 10 * time.Minute
+	DefaultDeleteTimeout = 10 * time.Minute
+)
+
+// withTimeouts adds the timeouts block to a generated resource schema.
+func withTimeouts(ctx context.Context, s schema.Schema) schema.Schema {
+	if s.Blocks == nil {
+		s.Blocks = map[string]schema.Block{}
+	}
+
+	s.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+
+	return s
+}
+
+// nullTimeouts returns an unset timeouts block for resources that are not
+// read from a plan or state, e.g. list results.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
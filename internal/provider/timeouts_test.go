@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceTimeouts(t *testing.T) {
+	ctx := t.Context()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "uberspace"}, &metadata)
+
+		var resp resource.SchemaResponse
+
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+		if _, ok := resp.Schema.Blocks["timeouts"]; !ok {
+			t.Errorf("%s: missing timeouts block", metadata.TypeName)
+		}
+
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("%s: invalid schema: %v", metadata.TypeName, diags)
+		}
+	}
+}
+
+func TestResourceTimeoutsModel(t *testing.T) {
+	ctx := t.Context()
+
+	var schemaResp resource.SchemaResponse
+
+	(&WebdomainResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("schema is not an object")
+	}
+
+	values := map[string]tftypes.Value{}
+
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	timeoutsType, ok := objectType.AttributeTypes["timeouts"].(tftypes.Object)
+	if !ok {
+		t.Fatal("timeouts is not an object")
+	}
+
+	values["timeouts"] = tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+		"create": tftypes.NewValue(tftypes.String, "1m"),
+		"read":   tftypes.NewValue(tftypes.String, nil),
+		"update": tftypes.NewValue(tftypes.String, nil),
+		"delete": tftypes.NewValue(tftypes.String, nil),
+	})
+	values["name"] = tftypes.NewValue(tftypes.String, "isabell.example")
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	var model webdomainModel
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if model.Name.ValueString() != "isabell.example" {
+		t.Errorf("Name = %q, want %q", model.Name.ValueString(), "isabell.example")
+	}
+
+	createTimeout, diags := model.Timeouts.Create(ctx, DefaultCreateTimeout)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if createTimeout != time.Minute {
+		t.Errorf("create timeout = %s, want %s", createTimeout, time.Minute)
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, DefaultReadTimeout)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if readTimeout != DefaultReadTimeout {
+		t.Errorf("read timeout = %s, want %s", readTimeout, DefaultReadTimeout)
+	}
+}
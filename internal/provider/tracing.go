@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"iter"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans of Terraform
+// operations.
+const tracerName = "github.com/uberspace-community/terraform-provider-uberspace"
+
+// tracingEnabled reports whether tracing was requested through the standard
+// OpenTelemetry environment variables.
+func tracingEnabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+
+	if exporter := os.Getenv("OTEL_TRACES_EXPORTER"); exporter != "" {
+		return exporter == "otlp"
+	}
+
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// SetupTracing installs a global OTLP tracer provider if tracing is enabled
+// via OTEL_* environment variables. The generated client records a span for
+// every API call on the global provider. The returned function flushes
+// pending spans and must be called before the process exits.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	if !tracingEnabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName("terraform-provider-uberspace"),
+			semconv.ServiceVersion(version),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attributes from OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence.
+	envResource, err := resource.New(ctx, resource.WithFromEnv())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = resource.Merge(res, envResource)
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return tracerProvider.Shutdown, nil
+}
+
+// tracedProviderServer is the protocol server of the framework, including
+// the optional list resource and action RPCs.
+type tracedProviderServer interface {
+	tfprotov6.ProviderServerWithListResource
+	tfprotov6.ProviderServerWithActions
+}
+
+// tracedServer starts a span for every RPC that calls the Uberspace API and
+// passes its context down, so the spans of the API calls of one Terraform
+// operation share a parent.
+type tracedServer struct {
+	tracedProviderServer
+}
+
+// TraceServer wraps a protocol server to group the API call spans by
+// Terraform operation. Servers without list resource and action RPCs are
+// returned unchanged.
+func TraceServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	downstream, ok := server.(tracedProviderServer)
+	if !ok {
+		return server
+	}
+
+	return &tracedServer{downstream}
+}
+
+func startSpan(ctx context.Context, rpc, typeName string) (context.Context, trace.Span) {
+	name := rpc
+	if typeName != "" {
+		name += " " + typeName
+	}
+
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attribute.String("terraform.type_name", typeName)))
+}
+
+// endSpan ends the span of an RPC and marks it as failed if the RPC returned
+// an error or error diagnostics.
+func endSpan(span trace.Span, diagnostics []*tfprotov6.Diagnostic, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	for _, d := range diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, d.Summary)
+			return
+		}
+	}
+}
+
+// traceStream ends the span once a streamed response was consumed.
+func traceStream[T any](span trace.Span, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		defer span.End()
+
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (s *tracedServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	ctx, span := startSpan(ctx, "ConfigureProvider", "")
+	resp, err := s.tracedProviderServer.ConfigureProvider(ctx, req)
+
+	if resp != nil {
+		endSpan(span, resp.Diagnostics, err)
+	} else {
+		endSpan(span, nil, err)
+	}
+
+	return resp, err
+}
+
+func (s *tracedServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, span := startSpan(ctx, "ReadResource", req.TypeName)
+	resp, err := s.tracedProviderServer.ReadResource(ctx, req)
+
+	if resp != nil {
+		endSpan(span, resp.Diagnostics, err)
+	} else {
+		endSpan(span, nil, err)
+	}
+
+	return resp, err
+}
+
+func (s *tracedServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx, span := startSpan(ctx, "ApplyResourceChange", req.TypeName)
+	resp, err := s.tracedProviderServer.ApplyResourceChange(ctx, req)
+
+	if resp != nil {
+		endSpan(span, resp.Diagnostics, err)
+	} else {
+		endSpan(span, nil, err)
+	}
+
+	return resp, err
+}
+
+func (s *tracedServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := startSpan(ctx, "ImportResourceState", req.TypeName)
+	resp, err := s.tracedProviderServer.ImportResourceState(ctx, req)
+
+	if resp != nil {
+		endSpan(span, resp.Diagnostics, err)
+	} else {
+		endSpan(span, nil, err)
+	}
+
+	return resp, err
+}
+
+func (s *tracedServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, span := startSpan(ctx, "ReadDataSource", req.TypeName)
+	resp, err := s.tracedProviderServer.ReadDataSource(ctx, req)
+
+	if resp != nil {
+		endSpan(span, resp.Diagnostics, err)
+	} else {
+		endSpan(span, nil, err)
+	}
+
+	return resp, err
+}
+
+func (s *tracedServer) ListResource(ctx context.Context, req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	ctx, span := startSpan(ctx, "ListResource", req.TypeName)
+	stream, err := s.tracedProviderServer.ListResource(ctx, req)
+
+	if err != nil || stream == nil {
+		endSpan(span, nil, err)
+		return stream, err
+	}
+
+	// the API is called while the results are streamed
+	stream.Results = traceStream(span, stream.Results)
+
+	return stream, nil
+}
+
+func (s *tracedServer) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	ctx, span := startSpan(ctx, "InvokeAction", req.ActionType)
+	stream, err := s.tracedProviderServer.InvokeAction(ctx, req)
+
+	if err != nil || stream == nil {
+		endSpan(span, nil, err)
+		return stream, err
+	}
+
+	// the action runs while its events are streamed
+	stream.Events = traceStream(span, stream.Events)
+
+	return stream, nil
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{
+			name: "not configured",
+			want: false,
+		},
+		{
+			name: "endpoint",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			want: true,
+		},
+		{
+			name: "traces endpoint",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"},
+			want: true,
+		},
+		{
+			name: "otlp exporter",
+			env:  map[string]string{"OTEL_TRACES_EXPORTER": "otlp"},
+			want: true,
+		},
+		{
+			name: "other exporter",
+			env:  map[string]string{"OTEL_TRACES_EXPORTER": "none", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			want: false,
+		},
+		{
+			name: "sdk disabled",
+			env:  map[string]string{"OTEL_SDK_DISABLED": "true", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"OTEL_SDK_DISABLED", "OTEL_TRACES_EXPORTER", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			if got := tracingEnabled(); got != tt.want {
+				t.Errorf("tracingEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeTracedServer stands in for the framework and calls "the API" while
+// handling an RPC or streaming its results.
+type fakeTracedServer struct {
+	tracedProviderServer
+}
+
+func (fakeTracedServer) ReadResource(ctx context.Context, _ *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	_, span := otel.Tracer("test").Start(ctx, "GET")
+	span.End()
+
+	return &tfprotov6.ReadResourceResponse{}, nil
+}
+
+func (fakeTracedServer) ListResource(ctx context.Context, _ *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	return &tfprotov6.ListResourceServerStream{
+		Results: func(yield func(tfprotov6.ListResourceResult) bool) {
+			_, span := otel.Tracer("test").Start(ctx, "GET")
+			span.End()
+
+			yield(tfprotov6.ListResourceResult{})
+		},
+	}, nil
+}
+
+func TestTraceServer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	server := TraceServer(fakeTracedServer{}).(tracedProviderServer)
+
+	if _, err := server.ReadResource(t.Context(), &tfprotov6.ReadResourceRequest{TypeName: "uberspace_webdomain"}); err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := server.ListResource(t.Context(), &tfprotov6.ListResourceRequest{TypeName: "uberspace_webdomain"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ended := len(recorder.Ended()); ended != 2 {
+		t.Fatalf("got %d ended spans before the results were streamed, want 2", ended)
+	}
+
+	next, stop := iter.Pull(stream.Results)
+	next()
+	stop()
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("got %d spans, want 4", len(spans))
+	}
+
+	for i, want := range []string{"ReadResource uberspace_webdomain", "ListResource uberspace_webdomain"} {
+		call, operation := spans[2*i], spans[2*i+1]
+
+		if operation.Name() != want {
+			t.Errorf("got operation span %q, want %q", operation.Name(), want)
+		}
+
+		if call.Parent().SpanID() != operation.SpanContext().SpanID() || call.SpanContext().TraceID() != operation.SpanContext().TraceID() {
+			t.Errorf("API call span is not a child of %q", want)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebbackendsDataSource{}
+
+func NewWebbackendsDataSource() datasource.DataSource {
+	return &WebbackendsDataSource{}
+}
+
+// WebbackendsDataSource defines the data source implementation.
+type WebbackendsDataSource struct {
+	client *client.Client
+}
+
+type webbackendsDataSourceModel struct {
+	Asteroid    types.String          `tfsdk:"asteroid"`
+	Domain      types.String          `tfsdk:"domain"`
+	Path        types.String          `tfsdk:"path"`
+	Webbackends []webbackendDataModel `tfsdk:"webbackends"`
+}
+
+type webbackendDataModel struct {
+	Pk           types.Int64  `tfsdk:"pk"`
+	Asteroid     types.String `tfsdk:"asteroid"`
+	Domain       types.String `tfsdk:"domain"`
+	Path         types.String `tfsdk:"path"`
+	RemovePrefix types.Bool   `tfsdk:"remove_prefix"`
+	Destination  types.String `tfsdk:"destination"`
+	Port         types.Int64  `tfsdk:"port"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+func (d *WebbackendsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webbackends"
+}
+
+func (d *WebbackendsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "All web backends of an asteroid, including the default backends without a domain.",
+		Attributes: map[string]schema.Attribute{
+			"asteroid": schema.StringAttribute{
+				Description: "Name of a hosting account, e.g. 'isabell'.",
+				Required:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Only return web backends of this domain. Use an empty string for the default backends without a domain.",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Only return web backends with this path, e.g. `/`.",
+				Optional:    true,
+			},
+			"webbackends": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pk": schema.Int64Attribute{
+							Computed: true,
+						},
+						"asteroid": schema.StringAttribute{
+							Description: "Name of a hosting account, e.g. 'isabell'.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "Domain of the web backend, null for default backends.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Computed: true,
+						},
+						"remove_prefix": schema.BoolAttribute{
+							Description: "Whether to remove the path while proxying, e.g. /ep/123 => /123.",
+							Computed:    true,
+						},
+						"destination": schema.StringAttribute{
+							Description: "One of `APACHE`, `STATIC` or `PORT`.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "TCP port of the upstream HTTP server.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebbackendsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *WebbackendsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webbackendsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backends, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebBackend], error) {
+		return d.client.AsteroidsWebbackendsList(ctx, client.AsteroidsWebbackendsListParams{
+			AsteroidName: data.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read web backends", err)
+		return
+	}
+
+	data.Webbackends = []webbackendDataModel{}
+
+	for _, backend := range backends {
+		if !data.Domain.IsNull() && backend.Domain.Or("") != data.Domain.ValueString() {
+			continue
+		}
+
+		if !data.Path.IsNull() && backend.Path != data.Path.ValueString() {
+			continue
+		}
+
+		model := webbackendDataModel{
+			Pk:           types.Int64Value(int64(backend.Pk)),
+			Asteroid:     types.StringValue(backend.Asteroid),
+			Domain:       types.StringNull(),
+			Path:         types.StringValue(backend.Path),
+			RemovePrefix: types.BoolValue(backend.RemovePrefix.Or(false)),
+			Destination:  types.StringValue(string(backend.Destination)),
+			Port:         types.Int64Null(),
+			CreatedAt:    types.StringValue(backend.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:    types.StringValue(backend.UpdatedAt.Format(time.RFC3339)),
+		}
+
+		if domain, ok := backend.Domain.Get(); ok {
+			model.Domain = types.StringValue(domain)
+		}
+
+		if port, ok := backend.Port.Get(); ok {
+			model.Port = types.Int64Value(int64(port))
+		}
+
+		data.Webbackends = append(data.Webbackends, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWebbackendsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebbackendsDataSourceConfig("terra", "backends.terra.uber.space", 8080),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uberspace_webbackends.test",
+						tfjsonpath.New("webbackends"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"domain": knownvalue.StringExact("backends.terra.uber.space"),
+								"path":   knownvalue.StringExact("/api"),
+								"port":   knownvalue.Int64Exact(8080),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccWebbackendsDataSourceConfig(asteroid, domain string, port int) string {
+	return fmt.Sprintf(`
+resource "uberspace_webdomain" "test" {
+  asteroid = %[1]q
+  name     = %[2]q
+}
+
+resource "uberspace_webdomain_backend" "test" {
+  asteroid    = %[1]q
+  domain      = uberspace_webdomain.test.name
+  destination = "PORT"
+  port        = %[3]d
+  path        = "/api"
+}
+
+data "uberspace_webbackends" "test" {
+  asteroid = %[1]q
+  domain   = uberspace_webdomain_backend.test.domain
+  path     = "/api"
+}
+`, asteroid, domain, port)
+}
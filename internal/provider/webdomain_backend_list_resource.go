@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &WebdomainBackendListResource{}
+	_ list.ListResourceWithConfigure = &WebdomainBackendListResource{}
+)
+
+func NewWebdomainBackendListResource() list.ListResource {
+	return &WebdomainBackendListResource{}
+}
+
+// WebdomainBackendListResource lists the web domain backends of an asteroid.
+type WebdomainBackendListResource struct {
+	client *client.Client
+}
+
+func (r *WebdomainBackendListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webdomain_backend"
+}
+
+func (r *WebdomainBackendListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("Lists the web domain backends of an asteroid. Default backends without a domain are skipped.")
+}
+
+func (r *WebdomainBackendListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = listClient(req, resp)
+}
+
+func (r *WebdomainBackendListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listConfigModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	fetch := func(ctx context.Context, limit, offset int) (pagination.Page[client.WebBackend], error) {
+		return r.client.AsteroidsWebbackendsList(ctx, client.AsteroidsWebbackendsListParams{
+			AsteroidName: config.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	}
+
+	stream.Results = listResults(ctx, req, "Unable to read web domain backends", fetch, func(ctx context.Context, backend *client.WebBackend, result *list.ListResult) bool {
+		// the asteroid-wide default backends have no domain and cannot be imported
+		domain, ok := backend.Domain.Get()
+		if !ok {
+			return false
+		}
+
+		model := webdomainBackendModel{Timeouts: nullTimeouts()}
+		model.fromAPI(backend)
+
+		if model.Port.IsUnknown() {
+			model.Port = types.Int64Null()
+		}
+
+		result.DisplayName = domain + backend.Path
+		result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		}
+
+		return true
+	})
+}
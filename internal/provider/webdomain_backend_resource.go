@@ -3,16 +3,62 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/provider/resource_webdomain_backend"
 )
 
-var _ resource.Resource = &WebdomainBackendResource{}
+var (
+	_ resource.Resource                 = &WebdomainBackendResource{}
+	_ resource.ResourceWithIdentity     = &WebdomainBackendResource{}
+	_ resource.ResourceWithImportState  = &WebdomainBackendResource{}
+	_ resource.ResourceWithUpgradeState = &WebdomainBackendResource{}
+)
+
+// webdomainBackendModel extends the generated model with the timeouts block.
+type webdomainBackendModel struct {
+	resource_webdomain_backend.WebdomainBackendModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// webdomainBackendIdentityModel describes the resource identity.
+type webdomainBackendIdentityModel struct {
+	Asteroid types.String `tfsdk:"asteroid"`
+	Domain   types.String `tfsdk:"domain"`
+	Path     types.String `tfsdk:"path"`
+}
+
+func (m *webdomainBackendModel) identity() webdomainBackendIdentityModel {
+	return webdomainBackendIdentityModel{
+		Asteroid: m.Asteroid,
+		Domain:   m.Domain,
+		Path:     m.Path,
+	}
+}
+
+// fromAPI sets the model from a web domain backend returned by the API.
+func (m *webdomainBackendModel) fromAPI(backend *client.WebBackend) {
+	m.Asteroid = types.StringValue(backend.Asteroid)
+	m.AsteroidName = types.StringValue(backend.Asteroid)
+	m.CreatedAt = types.StringValue(backend.CreatedAt.Format(time.RFC3339))
+	m.Destination = types.StringValue(string(backend.Destination))
+	m.Domain = types.StringValue(backend.Domain.Or(""))
+	m.Format = types.StringValue("json")
+	m.Path = types.StringValue(backend.Path)
+	m.Pk = types.Int64Value(int64(backend.Pk))
+	m.Port = toInt64Value(backend.Port)
+	m.RemovePrefix = types.BoolValue(backend.RemovePrefix.Or(false))
+	m.UpdatedAt = types.StringValue(backend.UpdatedAt.Format(time.RFC3339))
+	m.WebdomainName = types.StringValue(backend.Domain.Or(""))
+}
 
 func NewWebdomainBackendResource() resource.Resource {
 	return &WebdomainBackendResource{}
@@ -20,7 +66,10 @@ func NewWebdomainBackendResource() resource.Resource {
 
 // WebdomainBackendResource defines the resource implementation.
 type WebdomainBackendResource struct {
-	client *client.Client
+	client        *client.Client
+	events        *EventWaiter
+	cache         *ReadCache
+	adoptExisting bool
 }
 
 func (r *WebdomainBackendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -28,7 +77,27 @@ func (r *WebdomainBackendResource) Metadata(_ context.Context, req resource.Meta
 }
 
 func (r *WebdomainBackendResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_webdomain_backend.WebdomainBackendResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, resource_webdomain_backend.WebdomainBackendResourceSchema(ctx))
+	resp.Schema.Version = 1
+}
+
+func (r *WebdomainBackendResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"asteroid": identityschema.StringAttribute{
+				Description:       "Name of the asteroid the backend belongs to.",
+				RequiredForImport: true,
+			},
+			"domain": identityschema.StringAttribute{
+				Description:       "Web domain of the backend.",
+				RequiredForImport: true,
+			},
+			"path": identityschema.StringAttribute{
+				Description:       "Path of the backend, e.g. `/`.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *WebdomainBackendResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -36,21 +105,24 @@ func (r *WebdomainBackendResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *uberspace.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
+	r.events = data.Events
+	r.cache = data.Cache
+	r.adoptExisting = data.AdoptExisting
 }
 
 func (r *WebdomainBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_webdomain_backend.WebdomainBackendModel
+	var plan webdomainBackendModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -58,6 +130,22 @@ func (r *WebdomainBackendResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	reqBody := client.WebBackendRequest{
 		Asteroid:     plan.Asteroid.ValueString(),
 		Domain:       client.NewNilString(plan.Domain.ValueString()),
@@ -73,36 +161,68 @@ func (r *WebdomainBackendResource) Create(ctx context.Context, req resource.Crea
 		AsteroidName:  plan.Asteroid.ValueString(),
 		WebdomainName: plan.Domain.ValueString(),
 	})
+	adopted := false
+	if err != nil && r.adoptExisting && isConflict(err) {
+		adopted = true
+		backend, err = r.client.AsteroidsWebdomainsBackendsGet(ctx, client.AsteroidsWebdomainsBackendsGetParams{
+			AsteroidName:  plan.Asteroid.ValueString(),
+			WebdomainName: plan.Domain.ValueString(),
+			Path:          plan.Path.ValueString(),
+		})
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create web domain backend, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create web domain backend", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(backend.Asteroid)
-	plan.AsteroidName = types.StringValue(backend.Asteroid)
-	plan.CreatedAt = types.StringValue(backend.CreatedAt.Format(time.RFC3339))
-	plan.Destination = types.StringValue(string(backend.Destination))
-	plan.Domain = types.StringValue(backend.Domain.Or(""))
-	plan.Format = types.StringValue("json")
-	plan.Path = types.StringValue(backend.Path)
-	plan.Pk = types.Int64Value(int64(backend.Pk))
-	plan.Port = toInt64Value(backend.Port)
-	plan.RemovePrefix = types.BoolValue(backend.RemovePrefix.Or(false))
-	plan.UpdatedAt = types.StringValue(backend.UpdatedAt.Format(time.RFC3339))
-	plan.WebdomainName = types.StringValue(backend.Domain.Or(""))
+	if adopted {
+		if diffs := backendDiff(plan, backend); len(diffs) > 0 {
+			resp.Diagnostics.AddError(
+				"Existing Web Domain Backend Differs",
+				fmt.Sprintf("The web domain backend %s%s already exists with a different configuration and was not adopted:\n\n%s\n\nAlign the configuration with the existing backend, or import and then change it.",
+					plan.Domain.ValueString(), plan.Path.ValueString(), strings.Join(diffs, "\n")),
+			)
 
-	plan.Destination = types.StringValue(string(backend.Destination))
-	if v, ok := backend.Port.Get(); ok {
-		plan.Port = types.Int64Value(int64(v))
-	} else if backend.Port.IsNull() {
-		plan.Port = types.Int64Null()
+			return
+		}
+	}
+
+	plan.fromAPI(backend)
+
+	// adopting triggers no event to wait for
+	if !adopted {
+		if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebBackend, ID: backend.Pk}); err != nil {
+			addClientError(&resp.Diagnostics, "Unable to create web domain backend", err)
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+}
+
+// backendDiff lists the configured attributes in which an existing backend
+// differs from the plan. Unknown planned values match any existing value.
+func backendDiff(plan webdomainBackendModel, backend *client.WebBackend) []string {
+	var diffs []string
+
+	if destination := string(backend.Destination); plan.Destination.ValueString() != destination {
+		diffs = append(diffs, fmt.Sprintf("- destination: %q configured, %q existing", plan.Destination.ValueString(), destination))
+	}
+
+	if existing := toInt64Value(backend.Port); !plan.Port.IsUnknown() && !existing.IsUnknown() && !plan.Port.Equal(existing) {
+		diffs = append(diffs, fmt.Sprintf("- port: %s configured, %s existing", plan.Port, existing))
+	}
+
+	if existing := backend.RemovePrefix.Or(false); !plan.RemovePrefix.IsUnknown() && plan.RemovePrefix.ValueBool() != existing {
+		diffs = append(diffs, fmt.Sprintf("- remove_prefix: %t configured, %t existing", plan.RemovePrefix.ValueBool(), existing))
+	}
+
+	return diffs
 }
 
 func (r *WebdomainBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_webdomain_backend.WebdomainBackendModel
+	var state webdomainBackendModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -110,41 +230,34 @@ func (r *WebdomainBackendResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	backend, err := r.client.AsteroidsWebdomainsBackendsGet(ctx, client.AsteroidsWebdomainsBackendsGetParams{
+	readTimeout, diags := state.Timeouts.Read(ctx, DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	backend, err := r.cache.WebBackend(ctx, r.client, client.AsteroidsWebdomainsBackendsGetParams{
 		AsteroidName:  state.Asteroid.ValueString(),
 		WebdomainName: state.Domain.ValueString(),
 		Path:          state.Path.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read web domain backend, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read web domain backend", err)
 		return
 	}
 
-	state.Asteroid = types.StringValue(backend.Asteroid)
-	state.AsteroidName = types.StringValue(backend.Asteroid)
-	state.CreatedAt = types.StringValue(backend.CreatedAt.Format(time.RFC3339))
-	state.Destination = types.StringValue(string(backend.Destination))
-	state.Domain = types.StringValue(backend.Domain.Or(""))
-	state.Format = types.StringValue("json")
-	state.Path = types.StringValue(backend.Path)
-	state.Pk = types.Int64Value(int64(backend.Pk))
-	state.Port = toInt64Value(backend.Port)
-	state.RemovePrefix = types.BoolValue(backend.RemovePrefix.Or(false))
-	state.UpdatedAt = types.StringValue(backend.UpdatedAt.Format(time.RFC3339))
-	state.WebdomainName = types.StringValue(backend.Domain.Or(""))
-
-	state.Destination = types.StringValue(string(backend.Destination))
-	if v, ok := backend.Port.Get(); ok {
-		state.Port = types.Int64Value(int64(v))
-	} else if backend.Port.IsNull() {
-		state.Port = types.Int64Null()
-	}
+	state.fromAPI(backend)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *WebdomainBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state, plan resource_webdomain_backend.WebdomainBackendModel
+	var state, plan webdomainBackendModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -153,12 +266,28 @@ func (r *WebdomainBackendResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsWebdomainsBackendsDelete(ctx, client.AsteroidsWebdomainsBackendsDeleteParams{
 		AsteroidName:  state.Asteroid.ValueString(),
 		WebdomainName: state.Domain.ValueString(),
 		Path:          state.Path.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete web domain backend, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete web domain backend", err)
 		return
 	}
 
@@ -183,35 +312,22 @@ func (r *WebdomainBackendResource) Update(ctx context.Context, req resource.Upda
 		WebdomainName: plan.Domain.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create web domain backend, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create web domain backend", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(backend.Asteroid)
-	plan.AsteroidName = types.StringValue(backend.Asteroid)
-	plan.CreatedAt = types.StringValue(backend.CreatedAt.Format(time.RFC3339))
-	plan.Destination = types.StringValue(string(backend.Destination))
-	plan.Domain = types.StringValue(backend.Domain.Or(""))
-	plan.Format = types.StringValue("json")
-	plan.Path = types.StringValue(backend.Path)
-	plan.Pk = types.Int64Value(int64(backend.Pk))
-	plan.Port = toInt64Value(backend.Port)
-	plan.RemovePrefix = types.BoolValue(backend.RemovePrefix.Or(false))
-	plan.UpdatedAt = types.StringValue(backend.UpdatedAt.Format(time.RFC3339))
-	plan.WebdomainName = types.StringValue(backend.Domain.Or(""))
+	plan.fromAPI(backend)
 
-	plan.Destination = types.StringValue(string(backend.Destination))
-	if v, ok := backend.Port.Get(); ok {
-		plan.Port = types.Int64Value(int64(v))
-	} else if backend.Port.IsNull() {
-		plan.Port = types.Int64Null()
+	if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebBackend, ID: backend.Pk}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to update web domain backend", err)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *WebdomainBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_webdomain_backend.WebdomainBackendModel
+	var state webdomainBackendModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -219,14 +335,65 @@ func (r *WebdomainBackendResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, state.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsWebdomainsBackendsDelete(ctx, client.AsteroidsWebdomainsBackendsDeleteParams{
 		AsteroidName:  state.Asteroid.ValueString(),
 		WebdomainName: state.Domain.ValueString(),
 		Path:          state.Path.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete web domain backend, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete web domain backend", err)
 		return
 	}
+
+	if err := r.events.Wait(ctx, r.client, state.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebBackend, ID: int(state.Pk.ValueInt64())}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to delete web domain backend", err)
+	}
+}
+
+func (r *WebdomainBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []importAttribute{
+		{Identity: "asteroid", State: "asteroid"},
+		{Identity: "domain", State: "domain"},
+		{Identity: "path", State: "path"},
+	})
+}
+
+func (r *WebdomainBackendResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeState(webdomainBackendSchemaV0, nil),
+	}
+}
+
+// webdomainBackendSchemaV0 are the attribute types of the unversioned web backend schema.
+var webdomainBackendSchemaV0 = map[string]attr.Type{
+	"asteroid":       types.StringType,
+	"asteroid_name":  types.StringType,
+	"created_at":     types.StringType,
+	"destination":    types.StringType,
+	"domain":         types.StringType,
+	"format":         types.StringType,
+	"path":           types.StringType,
+	"pk":             types.Int64Type,
+	"port":           types.Int64Type,
+	"remove_prefix":  types.BoolType,
+	"updated_at":     types.StringType,
+	"webdomain_name": types.StringType,
+	"timeouts":       timeoutsV0,
 }
 
 func toOptNilInt(port types.Int64) (i client.OptNilInt) {
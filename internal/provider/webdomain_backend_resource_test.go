@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
 )
 
 func TestAccWebdomainBackendResource(t *testing.T) {
@@ -45,6 +48,12 @@ func TestAccWebdomainBackendResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by identity testing
+			{
+				ResourceName:    "uberspace_webdomain_backend.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{
 				Config: testAccWebdomainBackendResourceConfig("terra", "test-backend.terra.uber.space", 1024, "/terra-backend-updated", true),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -81,3 +90,39 @@ resource "uberspace_webdomain_backend" "test" {
 }
 `, asteroid, domain, port, path, removePrefix)
 }
+
+func TestBackendDiff(t *testing.T) {
+	existing := &client.WebBackend{
+		Destination:  client.DestinationEnumPORT,
+		Port:         client.NewOptNilInt(8080),
+		RemovePrefix: client.NewOptBool(false),
+	}
+
+	plan := func(destination string, port types.Int64, removePrefix types.Bool) webdomainBackendModel {
+		var m webdomainBackendModel
+
+		m.Destination = types.StringValue(destination)
+		m.Port = port
+		m.RemovePrefix = removePrefix
+
+		return m
+	}
+
+	tests := map[string]struct {
+		plan webdomainBackendModel
+		want int
+	}{
+		"same":           {plan: plan("PORT", types.Int64Value(8080), types.BoolValue(false))},
+		"unknown":        {plan: plan("PORT", types.Int64Unknown(), types.BoolUnknown())},
+		"destination":    {plan: plan("STATIC", types.Int64Unknown(), types.BoolValue(false)), want: 1},
+		"port":           {plan: plan("PORT", types.Int64Value(9000), types.BoolValue(false)), want: 1},
+		"remove prefix":  {plan: plan("PORT", types.Int64Value(8080), types.BoolValue(true)), want: 1},
+		"all attributes": {plan: plan("APACHE", types.Int64Value(9000), types.BoolValue(true)), want: 3},
+	}
+
+	for name, tt := range tests {
+		if got := backendDiff(tt.plan, existing); len(got) != tt.want {
+			t.Errorf("%s: got differences %q, want %d", name, got, tt.want)
+		}
+	}
+}
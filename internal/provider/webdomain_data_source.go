@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebdomainDataSource{}
+
+func NewWebdomainDataSource() datasource.DataSource {
+	return &WebdomainDataSource{}
+}
+
+// WebdomainDataSource defines the data source implementation.
+type WebdomainDataSource struct {
+	client *client.Client
+}
+
+type domainDataModel struct {
+	Asteroid           types.String `tfsdk:"asteroid"`
+	Name               types.String `tfsdk:"name"`
+	NameIdn            types.String `tfsdk:"name_idn"`
+	NameDisplay        types.String `tfsdk:"name_display"`
+	DnsState           types.String `tfsdk:"dns_state"`
+	DnsValidationToken types.String `tfsdk:"dns_validation_token"`
+	DnsLastCheck       types.String `tfsdk:"dns_last_check"`
+	DnsError           types.String `tfsdk:"dns_error"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+// newMaildomainDataModel converts a mail domain, which has the same fields as
+// a web domain.
+func newMaildomainDataModel(maildomain *client.MailDomain) domainDataModel {
+	return domainDataModel{
+		Asteroid:           types.StringValue(maildomain.Asteroid),
+		Name:               types.StringValue(maildomain.Name),
+		NameIdn:            types.StringValue(maildomain.NameIdn),
+		NameDisplay:        types.StringValue(maildomain.NameDisplay),
+		DnsState:           types.StringValue(string(maildomain.DNSState)),
+		DnsValidationToken: types.StringValue(maildomain.DNSValidationToken),
+		DnsLastCheck:       dateTimeValue(maildomain.DNSLastCheck),
+		DnsError:           stringValue(maildomain.DNSError),
+		CreatedAt:          types.StringValue(maildomain.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:          types.StringValue(maildomain.UpdatedAt.Format(time.RFC3339)),
+	}
+}
+
+func newWebdomainDataModel(webdomain *client.WebDomain) domainDataModel {
+	return domainDataModel{
+		Asteroid:           types.StringValue(webdomain.Asteroid),
+		Name:               types.StringValue(webdomain.Name),
+		NameIdn:            types.StringValue(webdomain.NameIdn),
+		NameDisplay:        types.StringValue(webdomain.NameDisplay),
+		DnsState:           types.StringValue(string(webdomain.DNSState)),
+		DnsValidationToken: types.StringValue(webdomain.DNSValidationToken),
+		DnsLastCheck:       dateTimeValue(webdomain.DNSLastCheck),
+		DnsError:           stringValue(webdomain.DNSError),
+		CreatedAt:          types.StringValue(webdomain.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:          types.StringValue(webdomain.UpdatedAt.Format(time.RFC3339)),
+	}
+}
+
+// dateTimeValue converts a nullable API time to an RFC 3339 string.
+func dateTimeValue(t client.NilDateTime) types.String {
+	if v, ok := t.Get(); ok {
+		return types.StringValue(v.Format(time.RFC3339))
+	}
+
+	return types.StringNull()
+}
+
+// stringValue converts a nullable API string.
+func stringValue(s client.NilString) types.String {
+	if v, ok := s.Get(); ok {
+		return types.StringValue(v)
+	}
+
+	return types.StringNull()
+}
+
+// domainDataSourceAttributes returns the computed attributes of a web or
+// mail domain, shared by the domain data sources.
+func domainDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"asteroid": schema.StringAttribute{
+			Description: "Name of a hosting account, e.g. 'isabell'.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"name_idn": schema.StringAttribute{
+			Description: "Name in its IDNA encoding, e.g. 'xn--mller-kva.example'.",
+			Computed:    true,
+		},
+		"name_display": schema.StringAttribute{
+			Computed: true,
+		},
+		"dns_state": schema.StringAttribute{
+			Description: "State of the DNS check, one of `VALID`, `INVALID`, `ERROR`, `UNCHECKED` or `IGNORED`.",
+			Computed:    true,
+		},
+		"dns_validation_token": schema.StringAttribute{
+			Description: "Token used to verify domain ownership via DNS TXT record.",
+			Computed:    true,
+		},
+		"dns_last_check": schema.StringAttribute{
+			Description: "When the DNS records were checked last.",
+			Computed:    true,
+		},
+		"dns_error": schema.StringAttribute{
+			Description: "Error encountered when checking DNS records.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"updated_at": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func (d *WebdomainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webdomain"
+}
+
+func (d *WebdomainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := domainDataSourceAttributes()
+	attributes["asteroid"] = schema.StringAttribute{
+		Description: "Name of a hosting account, e.g. 'isabell'.",
+		Required:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the web domain.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "A web domain of an asteroid.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *WebdomainDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *WebdomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config domainDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webdomain, err := d.client.AsteroidsWebdomainsGet(ctx, client.AsteroidsWebdomainsGetParams{
+		AsteroidName: config.Asteroid.ValueString(),
+		Name:         config.Name.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read web domain", err)
+		return
+	}
+
+	data := newWebdomainDataModel(webdomain)
+
+	// keep the configured name, the API may return it normalized
+	data.Name = config.Name
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+)
+
+func TestAccWebdomainDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebdomainDataSourceConfig("terra", "data.terra.uber.space"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uberspace_webdomain.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("data.terra.uber.space"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uberspace_webdomain.test",
+						tfjsonpath.New("dns_validation_token"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccWebdomainDataSourceConfig(asteroid, name string) string {
+	return fmt.Sprintf(`
+resource "uberspace_webdomain" "test" {
+  asteroid = %[1]q
+  name = %[2]q
+}
+
+data "uberspace_webdomain" "test" {
+  asteroid = uberspace_webdomain.test.asteroid
+  name = uberspace_webdomain.test.name
+}
+`, asteroid, name)
+}
+
+func TestNewMaildomainDataModel(t *testing.T) {
+	checked := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	data := newMaildomainDataModel(&client.MailDomain{
+		Asteroid:           "isabell",
+		Name:               "isabell.example",
+		NameIdn:            "isabell.example",
+		NameDisplay:        "isabell.example",
+		DNSState:           client.DnsStateEnumVALID,
+		DNSValidationToken: "token",
+		DNSLastCheck:       client.NewNilDateTime(checked),
+		DNSError:           client.NilString{Null: true},
+		CreatedAt:          checked,
+		UpdatedAt:          checked,
+	})
+
+	if data.Name.ValueString() != "isabell.example" || data.DnsState.ValueString() != "VALID" {
+		t.Errorf("got name %s and DNS state %s", data.Name, data.DnsState)
+	}
+
+	if data.DnsLastCheck.ValueString() != "2025-01-02T03:04:05Z" {
+		t.Errorf("got DNS last check %s", data.DnsLastCheck)
+	}
+
+	if !data.DnsError.IsNull() {
+		t.Errorf("got DNS error %s, want null", data.DnsError)
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &WebdomainHeaderListResource{}
+	_ list.ListResourceWithConfigure = &WebdomainHeaderListResource{}
+)
+
+func NewWebdomainHeaderListResource() list.ListResource {
+	return &WebdomainHeaderListResource{}
+}
+
+// WebdomainHeaderListResource lists the web domain headers of an asteroid.
+type WebdomainHeaderListResource struct {
+	client *client.Client
+}
+
+func (r *WebdomainHeaderListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webdomain_header"
+}
+
+func (r *WebdomainHeaderListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("Lists the web domain headers of an asteroid. Default headers without a domain are skipped.")
+}
+
+func (r *WebdomainHeaderListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = listClient(req, resp)
+}
+
+func (r *WebdomainHeaderListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listConfigModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	fetch := func(ctx context.Context, limit, offset int) (pagination.Page[client.WebHeader], error) {
+		return r.client.AsteroidsWebheadersList(ctx, client.AsteroidsWebheadersListParams{
+			AsteroidName: config.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	}
+
+	stream.Results = listResults(ctx, req, "Unable to read web domain headers", fetch, func(ctx context.Context, header *client.WebHeader, result *list.ListResult) bool {
+		// the asteroid-wide default headers have no domain and cannot be imported
+		domain, ok := header.Domain.Get()
+		if !ok {
+			return false
+		}
+
+		model := webdomainHeaderModel{Timeouts: nullTimeouts()}
+		model.fromAPI(header)
+
+		result.DisplayName = domain + header.Path + " " + header.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		}
+
+		return true
+	})
+}
@@ -6,14 +6,64 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/provider/resource_webdomain_header"
 )
 
-var _ resource.Resource = &WebdomainHeaderResource{}
+var (
+	_ resource.Resource                 = &WebdomainHeaderResource{}
+	_ resource.ResourceWithIdentity     = &WebdomainHeaderResource{}
+	_ resource.ResourceWithImportState  = &WebdomainHeaderResource{}
+	_ resource.ResourceWithUpgradeState = &WebdomainHeaderResource{}
+)
+
+// webdomainHeaderModel extends the generated model with the timeouts block.
+type webdomainHeaderModel struct {
+	resource_webdomain_header.WebdomainHeaderModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// webdomainHeaderIdentityModel describes the resource identity.
+type webdomainHeaderIdentityModel struct {
+	Asteroid types.String `tfsdk:"asteroid"`
+	Domain   types.String `tfsdk:"domain"`
+	ID       types.String `tfsdk:"id"`
+}
+
+func (m *webdomainHeaderModel) identity() webdomainHeaderIdentityModel {
+	return webdomainHeaderIdentityModel{
+		Asteroid: m.Asteroid,
+		Domain:   m.Domain,
+		ID:       m.Id,
+	}
+}
+
+// fromAPI sets the model from a web domain header returned by the API.
+func (m *webdomainHeaderModel) fromAPI(header *client.WebHeader) {
+	m.CreatedAt = types.StringValue(header.CreatedAt.Format(time.RFC3339))
+	m.UpdatedAt = types.StringValue(header.UpdatedAt.Format(time.RFC3339))
+	m.Asteroid = types.StringValue(header.Asteroid)
+	m.AsteroidName = types.StringValue(header.Asteroid)
+	m.Domain = types.StringValue(header.Domain.Or(""))
+	m.Format = types.StringValue("json")
+	m.WebdomainName = types.StringValue(header.Domain.Or(""))
+	m.Path = types.StringValue(header.Path)
+	m.Id = types.StringValue(strconv.Itoa(header.Pk))
+	m.Pk = types.Int64Value(int64(header.Pk))
+
+	m.Name = types.StringValue(header.Name)
+	if v, ok := header.Value.Get(); ok {
+		m.Value = types.StringValue(v)
+	} else if header.Value.IsNull() {
+		m.Value = types.StringNull()
+	}
+}
 
 func NewWebdomainHeaderResource() resource.Resource {
 	return &WebdomainHeaderResource{}
@@ -22,6 +72,8 @@ func NewWebdomainHeaderResource() resource.Resource {
 // WebdomainHeaderResource defines the resource implementation.
 type WebdomainHeaderResource struct {
 	client *client.Client
+	events *EventWaiter
+	cache  *ReadCache
 }
 
 func (r *WebdomainHeaderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -29,7 +81,27 @@ func (r *WebdomainHeaderResource) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *WebdomainHeaderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_webdomain_header.WebdomainHeaderResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, resource_webdomain_header.WebdomainHeaderResourceSchema(ctx))
+	resp.Schema.Version = 1
+}
+
+func (r *WebdomainHeaderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"asteroid": identityschema.StringAttribute{
+				Description:       "Name of the asteroid the header belongs to.",
+				RequiredForImport: true,
+			},
+			"domain": identityschema.StringAttribute{
+				Description:       "Web domain of the header.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the header.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *WebdomainHeaderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -37,21 +109,23 @@ func (r *WebdomainHeaderResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *uberspace.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
+	r.events = data.Events
+	r.cache = data.Cache
 }
 
 func (r *WebdomainHeaderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_webdomain_header.WebdomainHeaderModel
+	var plan webdomainHeaderModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -59,6 +133,22 @@ func (r *WebdomainHeaderResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	var value client.OptNilString
 	if !plan.Value.IsNull() {
 		value = client.NewOptNilString(plan.Value.ValueString())
@@ -79,33 +169,22 @@ func (r *WebdomainHeaderResource) Create(ctx context.Context, req resource.Creat
 		WebdomainName: plan.Domain.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create web domain header, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create web domain header", err)
 		return
 	}
 
-	plan.CreatedAt = types.StringValue(header.CreatedAt.Format(time.RFC3339))
-	plan.UpdatedAt = types.StringValue(header.UpdatedAt.Format(time.RFC3339))
-	plan.Asteroid = types.StringValue(header.Asteroid)
-	plan.AsteroidName = types.StringValue(header.Asteroid)
-	plan.Format = types.StringValue("json")
-	plan.Domain = types.StringValue(header.Domain.Or(""))
-	plan.WebdomainName = types.StringValue(header.Domain.Or(""))
-	plan.Path = types.StringValue(header.Path)
-	plan.Id = types.StringValue(strconv.Itoa(header.Pk))
-	plan.Pk = types.Int64Value(int64(header.Pk))
+	plan.fromAPI(header)
 
-	plan.Name = types.StringValue(header.Name)
-	if v, ok := header.Value.Get(); ok {
-		plan.Value = types.StringValue(v)
-	} else if header.Value.IsNull() {
-		plan.Value = types.StringNull()
+	if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebHeader, ID: header.Pk}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to create web domain header", err)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *WebdomainHeaderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_webdomain_header.WebdomainHeaderModel
+	var state webdomainHeaderModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -113,39 +192,34 @@ func (r *WebdomainHeaderResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	header, err := r.client.AsteroidsWebdomainsHeadersGet(ctx, client.AsteroidsWebdomainsHeadersGetParams{
+	readTimeout, diags := state.Timeouts.Read(ctx, DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	header, err := r.cache.WebHeader(ctx, r.client, client.AsteroidsWebdomainsHeadersGetParams{
 		AsteroidName:  state.Asteroid.ValueString(),
 		WebdomainName: state.Domain.ValueString(),
 		ID:            state.Id.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read web domain header, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read web domain header", err)
 		return
 	}
 
-	state.CreatedAt = types.StringValue(header.CreatedAt.Format(time.RFC3339))
-	state.UpdatedAt = types.StringValue(header.UpdatedAt.Format(time.RFC3339))
-	state.Asteroid = types.StringValue(header.Asteroid)
-	state.AsteroidName = types.StringValue(header.Asteroid)
-	state.Domain = types.StringValue(header.Domain.Or(""))
-	state.Format = types.StringValue("json")
-	state.WebdomainName = types.StringValue(header.Domain.Or(""))
-	state.Path = types.StringValue(header.Path)
-	state.Id = types.StringValue(strconv.Itoa(header.Pk))
-	state.Pk = types.Int64Value(int64(header.Pk))
-
-	state.Name = types.StringValue(header.Name)
-	if v, ok := header.Value.Get(); ok {
-		state.Value = types.StringValue(v)
-	} else if header.Value.IsNull() {
-		state.Value = types.StringNull()
-	}
+	state.fromAPI(header)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *WebdomainHeaderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state, plan resource_webdomain_header.WebdomainHeaderModel
+	var state, plan webdomainHeaderModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -154,12 +228,28 @@ func (r *WebdomainHeaderResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsWebdomainsHeadersDelete(ctx, client.AsteroidsWebdomainsHeadersDeleteParams{
 		AsteroidName:  state.Asteroid.ValueString(),
 		WebdomainName: state.Domain.ValueString(),
 		ID:            state.Id.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete web domain header, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete web domain header", err)
 		return
 	}
 
@@ -183,34 +273,22 @@ func (r *WebdomainHeaderResource) Update(ctx context.Context, req resource.Updat
 		WebdomainName: plan.Domain.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create web domain header, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create web domain header", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(header.Asteroid)
-	plan.AsteroidName = types.StringValue(header.Asteroid)
-	plan.CreatedAt = types.StringValue(header.CreatedAt.Format(time.RFC3339))
-	plan.Domain = types.StringValue(header.Domain.Or(""))
-	plan.Format = types.StringValue("json")
-	plan.Id = types.StringValue(strconv.Itoa(header.Pk))
-	plan.Name = types.StringValue(header.Name)
-	plan.Path = types.StringValue(header.Path)
-	plan.Pk = types.Int64Value(int64(header.Pk))
-	plan.UpdatedAt = types.StringValue(header.UpdatedAt.Format(time.RFC3339))
-	plan.WebdomainName = types.StringValue(header.Domain.Or(""))
+	plan.fromAPI(header)
 
-	plan.Name = types.StringValue(header.Name)
-	if v, ok := header.Value.Get(); ok {
-		plan.Value = types.StringValue(v)
-	} else if header.Value.IsNull() {
-		plan.Value = types.StringNull()
+	if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebHeader, ID: header.Pk}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to update web domain header", err)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *WebdomainHeaderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_webdomain_header.WebdomainHeaderModel
+	var state webdomainHeaderModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -218,12 +296,63 @@ func (r *WebdomainHeaderResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, state.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsWebdomainsHeadersDelete(ctx, client.AsteroidsWebdomainsHeadersDeleteParams{
 		AsteroidName:  state.Asteroid.ValueString(),
 		WebdomainName: state.Domain.ValueString(),
 		ID:            state.Id.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete web domain header, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete web domain header", err)
 		return
 	}
+
+	if err := r.events.Wait(ctx, r.client, state.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebHeader, ID: int(state.Pk.ValueInt64())}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to delete web domain header", err)
+	}
+}
+
+func (r *WebdomainHeaderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []importAttribute{
+		{Identity: "asteroid", State: "asteroid"},
+		{Identity: "domain", State: "domain"},
+		{Identity: "id", State: "id"},
+	})
+}
+
+func (r *WebdomainHeaderResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeState(webdomainHeaderSchemaV0, nil),
+	}
+}
+
+// webdomainHeaderSchemaV0 are the attribute types of the unversioned web header schema.
+var webdomainHeaderSchemaV0 = map[string]attr.Type{
+	"asteroid":       types.StringType,
+	"asteroid_name":  types.StringType,
+	"created_at":     types.StringType,
+	"domain":         types.StringType,
+	"format":         types.StringType,
+	"id":             types.StringType,
+	"name":           types.StringType,
+	"path":           types.StringType,
+	"pk":             types.Int64Type,
+	"updated_at":     types.StringType,
+	"value":          types.StringType,
+	"webdomain_name": types.StringType,
+	"timeouts":       timeoutsV0,
 }
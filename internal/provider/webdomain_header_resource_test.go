@@ -40,6 +40,12 @@ func TestAccWebdomainHeaderResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by identity testing
+			{
+				ResourceName:    "uberspace_webdomain_header.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{
 				Config: testAccWebdomainHeaderResourceConfig(
 					"terra",
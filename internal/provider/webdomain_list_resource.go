@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &WebdomainListResource{}
+	_ list.ListResourceWithConfigure = &WebdomainListResource{}
+)
+
+func NewWebdomainListResource() list.ListResource {
+	return &WebdomainListResource{}
+}
+
+// WebdomainListResource lists the web domains of an asteroid.
+type WebdomainListResource struct {
+	client *client.Client
+}
+
+func (r *WebdomainListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webdomain"
+}
+
+func (r *WebdomainListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("Lists the web domains of an asteroid.")
+}
+
+func (r *WebdomainListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = listClient(req, resp)
+}
+
+func (r *WebdomainListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listConfigModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	fetch := func(ctx context.Context, limit, offset int) (pagination.Page[client.WebDomain], error) {
+		return r.client.AsteroidsWebdomainsList(ctx, client.AsteroidsWebdomainsListParams{
+			AsteroidName: config.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	}
+
+	stream.Results = listResults(ctx, req, "Unable to read web domains", fetch, func(ctx context.Context, webdomain *client.WebDomain, result *list.ListResult) bool {
+		model := webdomainModel{Timeouts: nullTimeouts()}
+		model.fromAPI(webdomain)
+		model.Name = types.StringValue(webdomain.Name)
+
+		result.DisplayName = webdomain.NameDisplay
+		result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		}
+
+		return true
+	})
+}
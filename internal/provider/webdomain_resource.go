@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
@@ -14,17 +17,67 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &WebdomainResource{}
-	// _ resource.ResourceWithImportState = &WebdomainResource{}.
+	_ resource.Resource                 = &WebdomainResource{}
+	_ resource.ResourceWithIdentity     = &WebdomainResource{}
+	_ resource.ResourceWithImportState  = &WebdomainResource{}
+	_ resource.ResourceWithUpgradeState = &WebdomainResource{}
 )
 
+// webdomainModel extends the generated model with the timeouts block.
+type webdomainModel struct {
+	resource_webdomain.WebdomainModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// webdomainIdentityModel describes the resource identity.
+type webdomainIdentityModel struct {
+	Asteroid types.String `tfsdk:"asteroid"`
+	Name     types.String `tfsdk:"name"`
+}
+
+func (m *webdomainModel) identity() webdomainIdentityModel {
+	return webdomainIdentityModel{
+		Asteroid: m.Asteroid,
+		Name:     m.Name,
+	}
+}
+
+// fromAPI sets the model from a web domain returned by the API.
+func (m *webdomainModel) fromAPI(webdomain *client.WebDomain) {
+	m.Asteroid = types.StringValue(webdomain.Asteroid)
+	m.AsteroidName = types.StringValue(webdomain.Asteroid)
+	m.CreatedAt = types.StringValue(webdomain.CreatedAt.Format(time.RFC3339))
+	m.DnsValidationToken = types.StringValue(webdomain.DNSValidationToken)
+
+	m.DnsState = types.StringValue(string(webdomain.DNSState))
+	if lastCheck, ok := webdomain.DNSLastCheck.Get(); ok {
+		m.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
+	} else {
+		m.DnsLastCheck = types.StringNull()
+	}
+
+	if webdomain.DNSError.IsNull() {
+		m.DnsError = types.StringNull()
+	} else {
+		m.DnsError = types.StringValue(webdomain.DNSError.Or(""))
+	}
+
+	m.Format = types.StringValue("json")
+	m.Name = types.StringValue(webdomain.Name)
+	m.NameDisplay = types.StringValue(webdomain.NameDisplay)
+	m.NameIdn = types.StringValue(webdomain.NameIdn)
+	m.UpdatedAt = types.StringValue(webdomain.UpdatedAt.Format(time.RFC3339))
+}
+
 func NewWebdomainResource() resource.Resource {
 	return &WebdomainResource{}
 }
 
 // WebdomainResource defines the resource implementation.
 type WebdomainResource struct {
-	client *client.Client
+	client        *client.Client
+	events        *EventWaiter
+	adoptExisting bool
 }
 
 func (r *WebdomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -32,7 +85,23 @@ func (r *WebdomainResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 func (r *WebdomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_webdomain.WebdomainResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, resource_webdomain.WebdomainResourceSchema(ctx))
+	resp.Schema.Version = 1
+}
+
+func (r *WebdomainResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"asteroid": identityschema.StringAttribute{
+				Description:       "Name of the asteroid the web domain belongs to.",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the web domain.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *WebdomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -40,22 +109,24 @@ func (r *WebdomainResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *uberspace.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.events = data.Events
+	r.adoptExisting = data.AdoptExisting
 }
 
 func (r *WebdomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_webdomain.WebdomainModel
+	var plan webdomainModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -63,6 +134,22 @@ func (r *WebdomainResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	apiReq := client.AsteroidsWebdomainsCreateApplicationJSON(client.WebDomainRequest{
 		Name:     plan.Name.ValueString(),
 		Asteroid: plan.Asteroid.ValueString(),
@@ -71,40 +158,35 @@ func (r *WebdomainResource) Create(ctx context.Context, req resource.CreateReque
 	Webdomain, err := r.client.AsteroidsWebdomainsCreate(ctx, &apiReq, client.AsteroidsWebdomainsCreateParams{
 		AsteroidName: plan.Asteroid.ValueString(),
 	})
+	adopted := false
+	if err != nil && r.adoptExisting && isConflict(err) {
+		adopted = true
+		Webdomain, err = r.client.AsteroidsWebdomainsGet(ctx, client.AsteroidsWebdomainsGetParams{
+			AsteroidName: plan.Asteroid.ValueString(),
+			Name:         plan.Name.ValueString(),
+		})
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create web domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create web domain", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(Webdomain.Asteroid)
-	plan.AsteroidName = types.StringValue(Webdomain.Asteroid)
-	plan.CreatedAt = types.StringValue(Webdomain.CreatedAt.Format(time.RFC3339))
-	plan.DnsValidationToken = types.StringValue(Webdomain.DNSValidationToken)
+	plan.fromAPI(Webdomain)
 
-	plan.DnsState = types.StringValue(string(Webdomain.DNSState))
-	if lastCheck, ok := Webdomain.DNSLastCheck.Get(); ok {
-		plan.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
-	} else {
-		plan.DnsLastCheck = types.StringNull()
+	// adopting triggers no event to wait for
+	if !adopted {
+		if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebDomain, Names: []string{Webdomain.Name}}); err != nil {
+			addClientError(&resp.Diagnostics, "Unable to create web domain", err)
+		}
 	}
 
-	if Webdomain.DNSError.IsNull() {
-		plan.DnsError = types.StringNull()
-	} else {
-		plan.DnsError = types.StringValue(Webdomain.DNSError.Or(""))
-	}
-
-	plan.Format = types.StringValue("json")
-	plan.NameIdn = types.StringValue(Webdomain.Name)
-	plan.NameDisplay = types.StringValue(Webdomain.NameDisplay)
-	plan.NameIdn = types.StringValue(Webdomain.NameIdn)
-	plan.UpdatedAt = types.StringValue(Webdomain.UpdatedAt.Format(time.RFC3339))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *WebdomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_webdomain.WebdomainModel
+	var state webdomainModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -112,44 +194,33 @@ func (r *WebdomainResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	Webdomain, err := r.client.AsteroidsWebdomainsGet(ctx, client.AsteroidsWebdomainsGetParams{
 		AsteroidName: state.Asteroid.ValueString(),
 		Name:         state.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read web domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read web domain", err)
 		return
 	}
 
-	state.Asteroid = types.StringValue(Webdomain.Asteroid)
-	state.AsteroidName = types.StringValue(Webdomain.Asteroid)
-	state.CreatedAt = types.StringValue(Webdomain.CreatedAt.Format(time.RFC3339))
-	state.DnsValidationToken = types.StringValue(Webdomain.DNSValidationToken)
-
-	state.DnsState = types.StringValue(string(Webdomain.DNSState))
-	if lastCheck, ok := Webdomain.DNSLastCheck.Get(); ok {
-		state.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
-	} else {
-		state.DnsLastCheck = types.StringNull()
-	}
-
-	if Webdomain.DNSError.IsNull() {
-		state.DnsError = types.StringNull()
-	} else {
-		state.DnsError = types.StringValue(Webdomain.DNSError.Or(""))
-	}
-
-	state.Format = types.StringValue("json")
-	state.NameIdn = types.StringValue(Webdomain.Name)
-	state.NameDisplay = types.StringValue(Webdomain.NameDisplay)
-	state.NameIdn = types.StringValue(Webdomain.NameIdn)
-	state.UpdatedAt = types.StringValue(Webdomain.UpdatedAt.Format(time.RFC3339))
+	state.fromAPI(Webdomain)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *WebdomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state, plan resource_webdomain.WebdomainModel
+	var state, plan webdomainModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -158,10 +229,26 @@ func (r *WebdomainResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, plan.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsWebdomainsDelete(ctx, client.AsteroidsWebdomainsDeleteParams{
 		AsteroidName: state.Asteroid.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete web domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete web domain", err)
 		return
 	}
 
@@ -174,39 +261,22 @@ func (r *WebdomainResource) Update(ctx context.Context, req resource.UpdateReque
 		AsteroidName: plan.Asteroid.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create web domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create web domain", err)
 		return
 	}
 
-	plan.Asteroid = types.StringValue(Webdomain.Asteroid)
-	plan.AsteroidName = types.StringValue(Webdomain.Asteroid)
-	plan.CreatedAt = types.StringValue(Webdomain.CreatedAt.Format(time.RFC3339))
-	plan.DnsValidationToken = types.StringValue(Webdomain.DNSValidationToken)
+	plan.fromAPI(Webdomain)
 
-	plan.DnsState = types.StringValue(string(Webdomain.DNSState))
-	if lastCheck, ok := Webdomain.DNSLastCheck.Get(); ok {
-		plan.DnsLastCheck = types.StringValue(lastCheck.Format(time.RFC3339))
-	} else {
-		plan.DnsLastCheck = types.StringNull()
-	}
-
-	if Webdomain.DNSError.IsNull() {
-		plan.DnsError = types.StringNull()
-	} else {
-		plan.DnsError = types.StringValue(Webdomain.DNSError.Or(""))
+	if err := r.events.Wait(ctx, r.client, plan.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebDomain, Names: []string{Webdomain.Name}}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to update web domain", err)
 	}
 
-	plan.Format = types.StringValue("json")
-	plan.NameIdn = types.StringValue(Webdomain.Name)
-	plan.NameDisplay = types.StringValue(Webdomain.NameDisplay)
-	plan.NameIdn = types.StringValue(Webdomain.NameIdn)
-	plan.UpdatedAt = types.StringValue(Webdomain.UpdatedAt.Format(time.RFC3339))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *WebdomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_webdomain.WebdomainModel
+	var state webdomainModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -214,11 +284,61 @@ func (r *WebdomainResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	marker, err := r.events.Mark(ctx, r.client, state.Asteroid.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read events", err)
+		return
+	}
+
 	if err := r.client.AsteroidsWebdomainsDelete(ctx, client.AsteroidsWebdomainsDeleteParams{
 		AsteroidName: state.Asteroid.ValueString(),
 		Name:         state.Name.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete web domain, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete web domain", err)
 		return
 	}
+
+	if err := r.events.Wait(ctx, r.client, state.Asteroid.ValueString(), marker, EventObject{ContentType: contentTypeWebDomain, Names: []string{state.Name.ValueString()}}); err != nil {
+		addClientError(&resp.Diagnostics, "Unable to delete web domain", err)
+	}
+}
+
+func (r *WebdomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []importAttribute{
+		{Identity: "asteroid", State: "asteroid"},
+		{Identity: "name", State: "name"},
+	})
+}
+
+func (r *WebdomainResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeState(webdomainSchemaV0, nil),
+	}
+}
+
+// webdomainSchemaV0 are the attribute types of the unversioned web domain schema.
+var webdomainSchemaV0 = map[string]attr.Type{
+	"asteroid":             types.StringType,
+	"asteroid_name":        types.StringType,
+	"created_at":           types.StringType,
+	"dns_error":            types.StringType,
+	"dns_last_check":       types.StringType,
+	"dns_state":            types.StringType,
+	"dns_validation_token": types.StringType,
+	"format":               types.StringType,
+	"name":                 types.StringType,
+	"name_display":         types.StringType,
+	"name_idn":             types.StringType,
+	"updated_at":           types.StringType,
+	"timeouts":             timeoutsV0,
 }
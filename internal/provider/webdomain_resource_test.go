@@ -31,13 +31,12 @@ func TestAccWebdomainResource(t *testing.T) {
 					),
 				},
 			},
-			// ImportState testing
-			// {
-			// 	ResourceName:      "uberspace_webdomain.test",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
-			// Update and Read testing
+			// Import by identity testing
+			{
+				ResourceName:    "uberspace_webdomain.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{
 				Config: testAccWebdomainResourceConfig("terra", "test.terra.uber.space"),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebdomainsDataSource{}
+
+func NewWebdomainsDataSource() datasource.DataSource {
+	return &WebdomainsDataSource{}
+}
+
+// WebdomainsDataSource defines the data source implementation.
+type WebdomainsDataSource struct {
+	client *client.Client
+}
+
+type webdomainsDataSourceModel struct {
+	Asteroid   types.String      `tfsdk:"asteroid"`
+	DnsState   types.String      `tfsdk:"dns_state"`
+	Webdomains []domainDataModel `tfsdk:"webdomains"`
+}
+
+func (d *WebdomainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webdomains"
+}
+
+func (d *WebdomainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "All web domains of an asteroid.",
+		Attributes: map[string]schema.Attribute{
+			"asteroid": schema.StringAttribute{
+				Description: "Name of a hosting account, e.g. 'isabell'.",
+				Required:    true,
+			},
+			"dns_state": schema.StringAttribute{
+				Description: "Only return web domains in this DNS state, one of `VALID`, `INVALID`, `ERROR`, `UNCHECKED` or `IGNORED`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.DnsStateEnumVALID),
+						string(client.DnsStateEnumINVALID),
+						string(client.DnsStateEnumERROR),
+						string(client.DnsStateEnumUNCHECKED),
+						string(client.DnsStateEnumIGNORED),
+					),
+				},
+			},
+			"webdomains": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: domainDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *WebdomainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *WebdomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webdomainsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webdomains, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebDomain], error) {
+		return d.client.AsteroidsWebdomainsList(ctx, client.AsteroidsWebdomainsListParams{
+			AsteroidName: data.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read web domains", err)
+		return
+	}
+
+	data.Webdomains = []domainDataModel{}
+
+	for i := range webdomains {
+		if !data.DnsState.IsNull() && string(webdomains[i].DNSState) != data.DnsState.ValueString() {
+			continue
+		}
+
+		data.Webdomains = append(data.Webdomains, newWebdomainDataModel(&webdomains[i]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWebdomainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebdomainsDataSourceConfig("terra", "list.terra.uber.space"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uberspace_webdomains.test",
+						tfjsonpath.New("webdomains"),
+						knownvalue.ListPartial(map[int]knownvalue.Check{
+							0: knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"asteroid": knownvalue.StringExact("terra"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccWebdomainsDataSourceConfig(asteroid, name string) string {
+	return fmt.Sprintf(`
+resource "uberspace_webdomain" "test" {
+  asteroid = %[1]q
+  name = %[2]q
+}
+
+data "uberspace_webdomains" "test" {
+  asteroid = uberspace_webdomain.test.asteroid
+}
+`, asteroid, name)
+}
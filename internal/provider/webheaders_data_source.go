@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uberspace-community/terraform-provider-uberspace/gen/client"
+	"github.com/uberspace-community/terraform-provider-uberspace/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebheadersDataSource{}
+
+func NewWebheadersDataSource() datasource.DataSource {
+	return &WebheadersDataSource{}
+}
+
+// WebheadersDataSource defines the data source implementation.
+type WebheadersDataSource struct {
+	client *client.Client
+}
+
+type webheadersDataSourceModel struct {
+	Asteroid   types.String         `tfsdk:"asteroid"`
+	Domain     types.String         `tfsdk:"domain"`
+	Path       types.String         `tfsdk:"path"`
+	Webheaders []webheaderDataModel `tfsdk:"webheaders"`
+}
+
+type webheaderDataModel struct {
+	Pk        types.Int64  `tfsdk:"pk"`
+	Asteroid  types.String `tfsdk:"asteroid"`
+	Domain    types.String `tfsdk:"domain"`
+	Path      types.String `tfsdk:"path"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *WebheadersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webheaders"
+}
+
+func (d *WebheadersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "All web headers of an asteroid, including the default headers without a domain.",
+		Attributes: map[string]schema.Attribute{
+			"asteroid": schema.StringAttribute{
+				Description: "Name of a hosting account, e.g. 'isabell'.",
+				Required:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Only return web headers of this domain. Use an empty string for the default headers without a domain.",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Only return web headers with this path, e.g. `/`.",
+				Optional:    true,
+			},
+			"webheaders": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pk": schema.Int64Attribute{
+							Computed: true,
+						},
+						"asteroid": schema.StringAttribute{
+							Description: "Name of a hosting account, e.g. 'isabell'.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "Domain of the web header, null for default headers.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"value": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebheadersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *WebheadersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webheadersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, err := pagination.Collect(ctx, func(ctx context.Context, limit, offset int) (pagination.Page[client.WebHeader], error) {
+		return d.client.AsteroidsWebheadersList(ctx, client.AsteroidsWebheadersListParams{
+			AsteroidName: data.Asteroid.ValueString(),
+			Limit:        client.NewOptInt(limit),
+			Offset:       client.NewOptInt(offset),
+		})
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read web headers", err)
+		return
+	}
+
+	data.Webheaders = []webheaderDataModel{}
+
+	for _, header := range headers {
+		if !data.Domain.IsNull() && header.Domain.Or("") != data.Domain.ValueString() {
+			continue
+		}
+
+		if !data.Path.IsNull() && header.Path != data.Path.ValueString() {
+			continue
+		}
+
+		model := webheaderDataModel{
+			Pk:        types.Int64Value(int64(header.Pk)),
+			Asteroid:  types.StringValue(header.Asteroid),
+			Domain:    types.StringNull(),
+			Path:      types.StringValue(header.Path),
+			Name:      types.StringValue(header.Name),
+			Value:     types.StringNull(),
+			CreatedAt: types.StringValue(header.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt: types.StringValue(header.UpdatedAt.Format(time.RFC3339)),
+		}
+
+		if domain, ok := header.Domain.Get(); ok {
+			model.Domain = types.StringValue(domain)
+		}
+
+		if value, ok := header.Value.Get(); ok {
+			model.Value = types.StringValue(value)
+		}
+
+		data.Webheaders = append(data.Webheaders, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWebheadersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebheadersDataSourceConfig("terra", "headers.terra.uber.space"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uberspace_webheaders.test",
+						tfjsonpath.New("webheaders"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"domain": knownvalue.StringExact("headers.terra.uber.space"),
+								"name":   knownvalue.StringExact("X-Test"),
+								"value":  knownvalue.StringExact("test"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccWebheadersDataSourceConfig(asteroid, domain string) string {
+	return fmt.Sprintf(`
+resource "uberspace_webdomain" "test" {
+  asteroid = %[1]q
+  name     = %[2]q
+}
+
+resource "uberspace_webdomain_header" "test" {
+  asteroid = %[1]q
+  domain   = uberspace_webdomain.test.name
+  path     = "/"
+  name     = "X-Test"
+  value    = "test"
+}
+
+data "uberspace_webheaders" "test" {
+  asteroid = %[1]q
+  domain   = uberspace_webdomain_header.test.domain
+}
+`, asteroid, domain)
+}
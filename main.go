@@ -6,6 +6,8 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 
 	"github.com/uberspace-community/terraform-provider-uberspace/internal/provider"
 )
@@ -23,12 +25,28 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/uberspace-community/uberspace",
-		Debug:   debug,
+	var serveOpts []tf6server.ServeOpt
+
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	ctx := context.Background()
+
+	shutdownTracing, err := provider.SetupTracing(ctx, version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	// the traced server groups the API calls of a Terraform operation
+	err = tf6server.Serve("registry.terraform.io/uberspace-community/uberspace", func() tfprotov6.ProviderServer {
+		return provider.TraceServer(providerserver.NewProtocol6(provider.New(version)())())
+	}, serveOpts...)
+
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Print(shutdownErr.Error())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 	if err != nil {
 		log.Fatal(err.Error())
 	}